@@ -2,7 +2,9 @@ package entsoe
 
 import (
 	"fmt"
+	"sort"
 	"strings"
+	"time"
 )
 
 // https://transparency.entsoe.eu/content/static_content/Static%20content/web%20api/Guide.html#_areas
@@ -36,6 +38,48 @@ const (
 	Estonia   Area = "EE"
 	Lithuania Area = "LT"
 	Latvia    Area = "LV"
+
+	// Central Eastern Europe
+	Czechia  Area = "CZ"
+	Hungary  Area = "HU"
+	Romania  Area = "RO"
+	Slovakia Area = "SK"
+	Slovenia Area = "SI"
+	Croatia  Area = "HR"
+
+	// Alpine
+	Switzerland Area = "CH"
+
+	// Italy
+	ItalyNorth       Area = "IT-NORD"
+	ItalyCentreNorth Area = "IT-CNOR"
+	ItalyCentreSouth Area = "IT-CSUD"
+	ItalySouth       Area = "IT-SUD"
+	ItalyCalabria    Area = "IT-CALA"
+	ItalySicily      Area = "IT-SICI"
+	ItalySardinia    Area = "IT-SARD"
+
+	// Iberia
+	Spain    Area = "ES"
+	Portugal Area = "PT"
+
+	// South Eastern Europe
+	Albania           Area = "AL"
+	BosniaHerzegovina Area = "BA"
+	Bulgaria          Area = "BG"
+	Greece            Area = "GR"
+	Kosovo            Area = "XK"
+	Montenegro        Area = "ME"
+	NorthMacedonia    Area = "MK"
+	Serbia            Area = "RS"
+
+	// Islands
+	GreatBritain Area = "GB"
+	IrelandSEM   Area = "IE-SEM"
+
+	// Eastern Europe
+	Moldova    Area = "MD"
+	UkraineIPS Area = "UA-IPS"
 )
 
 var domains = map[Area]DomainType{
@@ -65,6 +109,48 @@ var domains = map[Area]DomainType{
 	Estonia:   DomainEE,
 	Lithuania: DomainLT,
 	Latvia:    DomainLV,
+
+	// Central Eastern Europe
+	Czechia:  DomainCZ,
+	Hungary:  DomainHU,
+	Romania:  DomainRO,
+	Slovakia: DomainSK,
+	Slovenia: DomainSI,
+	Croatia:  DomainHR,
+
+	// Alpine
+	Switzerland: DomainCH,
+
+	// Italy
+	ItalyNorth:       DomainITNorth,
+	ItalyCentreNorth: DomainITZCentreNorth,
+	ItalyCentreSouth: DomainITCentreSouth,
+	ItalySouth:       DomainITZSouth,
+	ItalyCalabria:    DomainITCalabria,
+	ItalySicily:      DomainITSicily,
+	ItalySardinia:    DomainITZSardinia,
+
+	// Iberia
+	Spain:    DomainES,
+	Portugal: DomainPT,
+
+	// South Eastern Europe
+	Albania:           DomainAL,
+	BosniaHerzegovina: DomainBA,
+	Bulgaria:          DomainBG,
+	Greece:            DomainGR,
+	Kosovo:            DomainXK,
+	Montenegro:        DomainME,
+	NorthMacedonia:    DomainMK,
+	Serbia:            DomainRS,
+
+	// Islands
+	GreatBritain: DomainGB,
+	IrelandSEM:   DomainIESEM,
+
+	// Eastern Europe
+	Moldova:    DomainMD,
+	UkraineIPS: DomainUAIPS,
 }
 
 var contryNames = map[Area]string{
@@ -94,6 +180,124 @@ var contryNames = map[Area]string{
 	Estonia:   "Estonia",
 	Lithuania: "Lithuania",
 	Latvia:    "Latvia",
+
+	// Central Eastern Europe
+	Czechia:  "Czechia",
+	Hungary:  "Hungary",
+	Romania:  "Romania",
+	Slovakia: "Slovakia",
+	Slovenia: "Slovenia",
+	Croatia:  "Croatia",
+
+	// Alpine
+	Switzerland: "Switzerland",
+
+	// Italy
+	ItalyNorth:       "Italy",
+	ItalyCentreNorth: "Italy",
+	ItalyCentreSouth: "Italy",
+	ItalySouth:       "Italy",
+	ItalyCalabria:    "Italy",
+	ItalySicily:      "Italy",
+	ItalySardinia:    "Italy",
+
+	// Iberia
+	Spain:    "Spain",
+	Portugal: "Portugal",
+
+	// South Eastern Europe
+	Albania:           "Albania",
+	BosniaHerzegovina: "Bosnia and Herzegovina",
+	Bulgaria:          "Bulgaria",
+	Greece:            "Greece",
+	Kosovo:            "Kosovo",
+	Montenegro:        "Montenegro",
+	NorthMacedonia:    "North Macedonia",
+	Serbia:            "Serbia",
+
+	// Islands
+	GreatBritain: "United Kingdom",
+	IrelandSEM:   "Ireland",
+
+	// Eastern Europe
+	Moldova:    "Moldova",
+	UkraineIPS: "Ukraine",
+}
+
+// cet is the market time of the single day-ahead coupling (SDAC): its delivery
+// days are CET days in every coupled zone, whatever the local civil time.
+const cet = "Europe/Brussels"
+
+// timeZones holds the market time zone of every area, the one its delivery days follow.
+var timeZones = map[Area]string{
+	// Central Western Europe
+	Austria:     "Europe/Vienna",
+	Belgium:     "Europe/Brussels",
+	France:      "Europe/Paris",
+	Germany:     "Europe/Berlin",
+	Netherlands: "Europe/Amsterdam",
+	Poland:      "Europe/Warsaw",
+
+	// Nordic
+	Denmark1: "Europe/Copenhagen",
+	Denmark2: "Europe/Copenhagen",
+	Finland:  cet,
+	Norway1:  "Europe/Oslo",
+	Norway2:  "Europe/Oslo",
+	Norway3:  "Europe/Oslo",
+	Norway4:  "Europe/Oslo",
+	Norway5:  "Europe/Oslo",
+	Sweden1:  "Europe/Stockholm",
+	Sweden2:  "Europe/Stockholm",
+	Sweden3:  "Europe/Stockholm",
+	Sweden4:  "Europe/Stockholm",
+
+	// Baltic
+	Estonia:   cet,
+	Lithuania: cet,
+	Latvia:    cet,
+
+	// Central Eastern Europe
+	Czechia:  "Europe/Prague",
+	Hungary:  "Europe/Budapest",
+	Romania:  cet,
+	Slovakia: "Europe/Bratislava",
+	Slovenia: "Europe/Ljubljana",
+	Croatia:  "Europe/Zagreb",
+
+	// Alpine
+	Switzerland: "Europe/Zurich",
+
+	// Italy
+	ItalyNorth:       "Europe/Rome",
+	ItalyCentreNorth: "Europe/Rome",
+	ItalyCentreSouth: "Europe/Rome",
+	ItalySouth:       "Europe/Rome",
+	ItalyCalabria:    "Europe/Rome",
+	ItalySicily:      "Europe/Rome",
+	ItalySardinia:    "Europe/Rome",
+
+	// Iberia
+	Spain:    "Europe/Madrid",
+	Portugal: cet,
+
+	// South Eastern Europe
+	Albania:           "Europe/Tirane",
+	BosniaHerzegovina: "Europe/Sarajevo",
+	Bulgaria:          cet,
+	Greece:            cet,
+	Kosovo:            "Europe/Belgrade",
+	Montenegro:        "Europe/Podgorica",
+	NorthMacedonia:    "Europe/Skopje",
+	Serbia:            "Europe/Belgrade",
+
+	// Islands, outside of SDAC
+	GreatBritain: "Europe/London",
+	IrelandSEM:   cet, // the SEM trading day runs from 23:00 to 23:00 Irish time
+
+	// Eastern Europe, outside of SDAC
+	Moldova:    "Europe/Chisinau",
+	UkraineIPS: "Europe/Kiev",
 }
 
 var domainToArea map[DomainType]Area
@@ -117,6 +321,22 @@ func areaName(domain DomainType) string {
 	return name
 }
 
+// domainLocation returns the market time zone of the first of domains which is
+// an Area, see Area.Location. It is UTC when there is none, such as for control
+// areas, or when the time zone database is missing.
+func domainLocation(domains ...DomainType) *time.Location {
+	for _, domain := range domains {
+		area, ok := domainToArea[domain]
+		if !ok {
+			continue
+		}
+		if loc, err := area.Location(); err == nil {
+			return loc
+		}
+	}
+	return time.UTC
+}
+
 func domain(area string) (DomainType, error) {
 	zone := Area(strings.ToUpper(area))
 
@@ -136,3 +356,28 @@ func (a Area) FullName() (string, error) {
 
 	return fullName, nil
 }
+
+// Location returns the market time zone of the area, which delivery days follow.
+// It is CET for the areas of the single day-ahead coupling, including the ones
+// whose civil time is WET or EET (Portugal, Finland, the Baltic states, Romania,
+// Bulgaria and Greece), and for Ireland, whose trading day runs from 23:00 to
+// 23:00 Irish time.
+func (a Area) Location() (*time.Location, error) {
+	name, ok := timeZones[a]
+	if !ok {
+		return nil, fmt.Errorf("unsupported area %s", a)
+	}
+	return time.LoadLocation(name)
+}
+
+// Areas returns every supported area, sorted by code.
+func Areas() []Area {
+	areas := make([]Area, 0, len(domains))
+	for area := range domains {
+		areas = append(areas, area)
+	}
+	sort.Slice(areas, func(i, j int) bool {
+		return areas[i] < areas[j]
+	})
+	return areas
+}
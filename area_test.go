@@ -0,0 +1,57 @@
+package entsoe
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var eicPattern = regexp.MustCompile(`^[0-9]{2}[A-Z0-9-]{13}[A-Z0-9]$`)
+
+func TestAreas(t *testing.T) {
+	areas := Areas()
+	assert.Len(t, areas, len(domains))
+
+	seen := make(map[DomainType]Area, len(areas))
+	for _, area := range areas {
+		d, err := domain(string(area))
+		if !assert.Nil(t, err, area) {
+			continue
+		}
+		assert.Regexp(t, eicPattern, d, area)
+		assert.Equal(t, "10Y", d[:3], "%s is not an area EIC", area)
+
+		other, dup := seen[d]
+		assert.False(t, dup, "%s and %s share %s", area, other, d)
+		seen[d] = area
+		assert.Equal(t, area, domainToArea[d])
+
+		name, err := area.FullName()
+		assert.Nil(t, err, area)
+		assert.NotEmpty(t, name, area)
+
+		_, ok := timeZones[area]
+		assert.True(t, ok, "%s has no time zone", area)
+		_, err = area.Location()
+		assert.Nil(t, err, area)
+	}
+}
+
+func TestAreasCoverBiddingZones(t *testing.T) {
+	for _, area := range []Area{
+		Spain, Portugal, Switzerland, Czechia, Slovakia, Hungary, Romania, Bulgaria, Greece, Slovenia, Croatia,
+		ItalyNorth, ItalyCentreNorth, ItalyCentreSouth, ItalySouth, ItalyCalabria, ItalySicily, ItalySardinia,
+		IrelandSEM, Serbia, Montenegro, NorthMacedonia, Albania, BosniaHerzegovina, Kosovo,
+	} {
+		_, err := NewDayAhead(area, nil)
+		assert.Nil(t, err, area)
+	}
+
+	d, err := domain("it-nord")
+	assert.Nil(t, err)
+	assert.Equal(t, DomainITNorth, d)
+
+	_, err = domain("XX")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,302 @@
+package entsoe
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// InterconnectorType is the technology of the lines of a border.
+type InterconnectorType string
+
+const (
+	InterconnectorAC   InterconnectorType = "AC"
+	InterconnectorDC   InterconnectorType = "DC"
+	InterconnectorACDC InterconnectorType = "AC+DC" // both AC lines and HVDC links
+)
+
+// Border is a direction between two neighbouring areas: flows and schedules go From -> To,
+// that is out_Domain From and in_Domain To.
+type Border struct {
+	From Area
+	To   Area
+}
+
+// Reverse returns the border in the other direction.
+func (b Border) Reverse() Border {
+	return Border{From: b.To, To: b.From}
+}
+
+// Type returns the interconnector type of the border, false if the areas are not neighbours.
+func (b Border) Type() (InterconnectorType, bool) {
+	if t, ok := interconnectors[b]; ok {
+		return t, true
+	}
+	t, ok := interconnectors[b.Reverse()]
+	return t, ok
+}
+
+// Borders returns every border between the areas once, with From before To, sorted.
+func Borders() []Border {
+	res := make([]Border, 0, len(interconnectors))
+	for b := range interconnectors {
+		if b.To < b.From {
+			b = b.Reverse()
+		}
+		res = append(res, b)
+	}
+	sortBorders(res)
+	return res
+}
+
+// Neighbours returns the areas sharing a border with area, sorted.
+func Neighbours(area Area) []Area {
+	var res []Area
+	for b := range interconnectors {
+		switch area {
+		case b.From:
+			res = append(res, b.To)
+		case b.To:
+			res = append(res, b.From)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i] < res[j]
+	})
+	return res
+}
+
+// bordersOf returns the borders of area in both directions, exports first.
+func bordersOf(area Area) []Border {
+	neighbours := Neighbours(area)
+	res := make([]Border, 0, 2*len(neighbours))
+	for _, n := range neighbours {
+		res = append(res, Border{From: area, To: n})
+	}
+	for _, n := range neighbours {
+		res = append(res, Border{From: n, To: area})
+	}
+	return res
+}
+
+func sortBorders(borders []Border) {
+	sort.Slice(borders, func(i, j int) bool {
+		if borders[i].From != borders[j].From {
+			return borders[i].From < borders[j].From
+		}
+		return borders[i].To < borders[j].To
+	})
+}
+
+// BorderFunc fetches a quantity of a border, such as GetPhysicalFlowsContext.
+// Other methods can be adapted with a closure fixing their extra parameters.
+type BorderFunc func(ctx context.Context, inDomain, outDomain DomainType, periodStart, periodEnd time.Time) (*PublicationMarketDocument, error)
+
+// BorderDocuments holds the documents fetched for the borders of an area.
+type BorderDocuments struct {
+	// Documents holds a document per border, exports and imports.
+	Documents map[Border]*PublicationMarketDocument
+	// Errors holds the borders which failed.
+	Errors map[Border]error
+}
+
+// FetchBorders calls fetch for every border of area, in both directions, concurrently.
+// A failing border does not fail the others, see BorderDocuments.Errors.
+// The only error returned is ctx.Err(), or the error of an unknown area.
+func FetchBorders(ctx context.Context, area Area, periodStart, periodEnd time.Time, fetch BorderFunc) (*BorderDocuments, error) {
+	if _, err := domain(string(area)); err != nil {
+		return nil, err
+	}
+
+	res := &BorderDocuments{
+		Documents: make(map[Border]*PublicationMarketDocument),
+		Errors:    make(map[Border]error),
+	}
+
+	// resolve every border before the requests, which write res under mu
+	type request struct {
+		border  Border
+		in, out DomainType
+	}
+	var requests []request
+	for _, b := range bordersOf(area) {
+		in, err := domain(string(b.To))
+		if err != nil {
+			res.Errors[b] = err
+			continue
+		}
+		out, err := domain(string(b.From))
+		if err != nil {
+			res.Errors[b] = err
+			continue
+		}
+		requests = append(requests, request{border: b, in: in, out: out})
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, r := range requests {
+		wg.Add(1)
+		go func(r request) {
+			defer wg.Done()
+
+			doc, err := fetch(ctx, r.in, r.out, periodStart, periodEnd)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				logger.Error().Err(err).Str("from", string(r.border.From)).Str("to", string(r.border.To)).Msg("Error fetching border")
+				res.Errors[r.border] = err
+				return
+			}
+			res.Documents[r.border] = doc
+		}(r)
+	}
+	wg.Wait()
+
+	return res, ctx.Err()
+}
+
+// GetPhysicalFlowsOfArea fetches the physical flows of every border of area, in both directions.
+func (c *EntsoeClient) GetPhysicalFlowsOfArea(
+	area Area,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BorderDocuments, error) {
+	return c.GetPhysicalFlowsOfAreaContext(context.Background(), area, periodStart, periodEnd)
+}
+
+// GetPhysicalFlowsOfAreaContext is like GetPhysicalFlowsOfArea but uses ctx for the HTTP requests.
+func (c *EntsoeClient) GetPhysicalFlowsOfAreaContext(
+	ctx context.Context,
+	area Area,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BorderDocuments, error) {
+	return FetchBorders(ctx, area, periodStart, periodEnd, c.GetPhysicalFlowsContext)
+}
+
+// interconnectors lists the borders between the areas, each in one direction only.
+var interconnectors = map[Border]InterconnectorType{
+	// Central Western Europe
+	{Austria, Czechia}:          InterconnectorAC,
+	{Austria, Germany}:          InterconnectorAC,
+	{Austria, Hungary}:          InterconnectorAC,
+	{Austria, ItalyNorth}:       InterconnectorAC,
+	{Austria, Slovenia}:         InterconnectorAC,
+	{Austria, Switzerland}:      InterconnectorAC,
+	{Belgium, France}:           InterconnectorAC,
+	{Belgium, Germany}:          InterconnectorDC,
+	{Belgium, GreatBritain}:     InterconnectorDC,
+	{Belgium, Netherlands}:      InterconnectorAC,
+	{France, Germany}:           InterconnectorAC,
+	{France, GreatBritain}:      InterconnectorDC,
+	{France, ItalyNorth}:        InterconnectorACDC,
+	{France, Spain}:             InterconnectorACDC,
+	{France, Switzerland}:       InterconnectorAC,
+	{Germany, Czechia}:          InterconnectorAC,
+	{Germany, Denmark1}:         InterconnectorAC,
+	{Germany, Denmark2}:         InterconnectorDC,
+	{Germany, Netherlands}:      InterconnectorAC,
+	{Germany, Norway2}:          InterconnectorDC,
+	{Germany, Poland}:           InterconnectorAC,
+	{Germany, Sweden4}:          InterconnectorDC,
+	{Germany, Switzerland}:      InterconnectorAC,
+	{Netherlands, Denmark1}:     InterconnectorDC,
+	{Netherlands, GreatBritain}: InterconnectorDC,
+	{Netherlands, Norway2}:      InterconnectorDC,
+	{Poland, Czechia}:           InterconnectorAC,
+	{Poland, Lithuania}:         InterconnectorDC,
+	{Poland, Slovakia}:          InterconnectorAC,
+	{Poland, Sweden4}:           InterconnectorDC,
+	{Poland, UkraineIPS}:        InterconnectorAC,
+
+	// Nordic
+	{Denmark1, Denmark2}:     InterconnectorDC,
+	{Denmark1, GreatBritain}: InterconnectorDC,
+	{Denmark1, Norway2}:      InterconnectorDC,
+	{Denmark1, Sweden3}:      InterconnectorDC,
+	{Denmark2, Sweden4}:      InterconnectorAC,
+	{Finland, Estonia}:       InterconnectorDC,
+	{Finland, Norway4}:       InterconnectorAC,
+	{Finland, Sweden1}:       InterconnectorAC,
+	{Finland, Sweden3}:       InterconnectorDC,
+	{Norway1, Norway2}:       InterconnectorAC,
+	{Norway1, Norway3}:       InterconnectorAC,
+	{Norway1, Norway5}:       InterconnectorAC,
+	{Norway1, Sweden3}:       InterconnectorAC,
+	{Norway2, GreatBritain}:  InterconnectorDC,
+	{Norway2, Norway5}:       InterconnectorAC,
+	{Norway3, Norway4}:       InterconnectorAC,
+	{Norway3, Norway5}:       InterconnectorAC,
+	{Norway3, Sweden2}:       InterconnectorAC,
+	{Norway4, Sweden1}:       InterconnectorAC,
+	{Norway4, Sweden2}:       InterconnectorAC,
+	{Sweden1, Sweden2}:       InterconnectorAC,
+	{Sweden2, Sweden3}:       InterconnectorAC,
+	{Sweden3, Sweden4}:       InterconnectorAC,
+	{Sweden4, Lithuania}:     InterconnectorDC,
+
+	// Baltic
+	{Estonia, Latvia}:   InterconnectorAC,
+	{Latvia, Lithuania}: InterconnectorAC,
+
+	// Central Eastern Europe
+	{Czechia, Slovakia}:          InterconnectorAC,
+	{Croatia, BosniaHerzegovina}: InterconnectorAC,
+	{Croatia, Hungary}:           InterconnectorAC,
+	{Croatia, Serbia}:            InterconnectorAC,
+	{Croatia, Slovenia}:          InterconnectorAC,
+	{Hungary, Romania}:           InterconnectorAC,
+	{Hungary, Serbia}:            InterconnectorAC,
+	{Hungary, Slovakia}:          InterconnectorAC,
+	{Hungary, Slovenia}:          InterconnectorAC,
+	{Hungary, UkraineIPS}:        InterconnectorAC,
+	{Romania, Bulgaria}:          InterconnectorAC,
+	{Romania, Moldova}:           InterconnectorAC,
+	{Romania, Serbia}:            InterconnectorAC,
+	{Romania, UkraineIPS}:        InterconnectorAC,
+	{Slovakia, UkraineIPS}:       InterconnectorAC,
+	{Slovenia, ItalyNorth}:       InterconnectorAC,
+
+	// Alpine
+	{Switzerland, ItalyNorth}: InterconnectorAC,
+
+	// Italy
+	{ItalyNorth, ItalyCentreNorth}:       InterconnectorAC,
+	{ItalyCentreNorth, ItalyCentreSouth}: InterconnectorAC,
+	{ItalyCentreNorth, ItalySardinia}:    InterconnectorDC,
+	{ItalyCentreSouth, ItalySouth}:       InterconnectorAC,
+	{ItalyCentreSouth, ItalySardinia}:    InterconnectorDC,
+	{ItalyCentreSouth, Montenegro}:       InterconnectorDC,
+	{ItalySouth, ItalyCalabria}:          InterconnectorAC,
+	{ItalySouth, Greece}:                 InterconnectorDC,
+	{ItalyCalabria, ItalySicily}:         InterconnectorAC,
+
+	// Iberia
+	{Spain, Portugal}: InterconnectorAC,
+
+	// South Eastern Europe
+	{Albania, Greece}:               InterconnectorAC,
+	{Albania, Kosovo}:               InterconnectorAC,
+	{Albania, Montenegro}:           InterconnectorAC,
+	{Albania, NorthMacedonia}:       InterconnectorAC,
+	{BosniaHerzegovina, Montenegro}: InterconnectorAC,
+	{BosniaHerzegovina, Serbia}:     InterconnectorAC,
+	{Bulgaria, Greece}:              InterconnectorAC,
+	{Bulgaria, NorthMacedonia}:      InterconnectorAC,
+	{Bulgaria, Serbia}:              InterconnectorAC,
+	{Greece, NorthMacedonia}:        InterconnectorAC,
+	{Kosovo, Montenegro}:            InterconnectorAC,
+	{Kosovo, NorthMacedonia}:        InterconnectorAC,
+	{Kosovo, Serbia}:                InterconnectorAC,
+	{Montenegro, Serbia}:            InterconnectorAC,
+	{NorthMacedonia, Serbia}:        InterconnectorAC,
+
+	// Islands
+	{GreatBritain, IrelandSEM}: InterconnectorDC,
+
+	// Eastern Europe
+	{Moldova, UkraineIPS}: InterconnectorAC,
+}
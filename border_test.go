@@ -0,0 +1,98 @@
+package entsoe
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBorders(t *testing.T) {
+	borders := Borders()
+	assert.Len(t, borders, len(interconnectors))
+
+	for _, b := range borders {
+		assert.True(t, b.From < b.To, b)
+		assert.NotEqual(t, b.From, b.To)
+		_, err := domain(string(b.From))
+		assert.Nil(t, err, b)
+		_, err = domain(string(b.To))
+		assert.Nil(t, err, b)
+
+		// listed once, in one direction
+		_, fwd := interconnectors[b]
+		_, rev := interconnectors[b.Reverse()]
+		assert.True(t, fwd != rev, b)
+	}
+
+	for _, area := range Areas() {
+		assert.NotEmpty(t, Neighbours(area), area)
+	}
+}
+
+func TestNeighbours(t *testing.T) {
+	assert.Equal(t, []Area{Belgium, Germany, Denmark1, GreatBritain, Norway2}, Neighbours(Netherlands))
+	assert.Equal(t, []Area{GreatBritain}, Neighbours(IrelandSEM))
+	assert.Empty(t, Neighbours("XX"))
+
+	typ, ok := Border{From: Spain, To: France}.Type()
+	assert.True(t, ok)
+	assert.Equal(t, InterconnectorACDC, typ)
+	typ, ok = Border{From: Norway2, To: Germany}.Type()
+	assert.True(t, ok)
+	assert.Equal(t, InterconnectorDC, typ)
+	_, ok = Border{From: Spain, To: Germany}.Type()
+	assert.False(t, ok)
+}
+
+func TestGetPhysicalFlowsOfArea(t *testing.T) {
+	var mu sync.Mutex
+	requested := make(map[Border]bool)
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		b := Border{From: domainToArea[q.Get(ParameterOutDomain)], To: domainToArea[q.Get(ParameterInDomain)]}
+		mu.Lock()
+		requested[b] = true
+		mu.Unlock()
+
+		if b.From == Portugal {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(testAcknowledgement("No matching data found")))
+			return
+		}
+		w.Write([]byte(testPublicationMarketDocument))
+	}, WithRetryPolicy(NoRetry), WithRateLimiter(nil))
+
+	res, err := c.GetPhysicalFlowsOfArea(Spain, genTime("202603012300"), genTime("202603020100"))
+	assert.Nil(t, err)
+	assert.Equal(t, map[Border]bool{
+		{Spain, France}:   true,
+		{France, Spain}:   true,
+		{Spain, Portugal}: true,
+		{Portugal, Spain}: true,
+	}, requested)
+	assert.Len(t, res.Documents, 3)
+	assert.Len(t, res.Errors, 1)
+	assert.NotNil(t, res.Errors[Border{Portugal, Spain}])
+
+	_, err = c.GetPhysicalFlowsOfArea("XX", genTime("202603012300"), genTime("202603020100"))
+	assert.NotNil(t, err)
+}
+
+func TestFetchBordersUnknownNeighbour(t *testing.T) {
+	unknown := Border{From: Spain, To: "XX"}
+	interconnectors[unknown] = InterconnectorAC
+	defer delete(interconnectors, unknown)
+
+	res, err := FetchBorders(context.Background(), Spain, genTime("202603012300"), genTime("202603020100"),
+		func(ctx context.Context, inDomain, outDomain DomainType, periodStart, periodEnd time.Time) (*PublicationMarketDocument, error) {
+			return &PublicationMarketDocument{}, nil
+		})
+	assert.Nil(t, err)
+	assert.Len(t, res.Documents, 4)
+	assert.NotNil(t, res.Errors[unknown])
+	assert.NotNil(t, res.Errors[unknown.Reverse()])
+}
@@ -0,0 +1,100 @@
+package entsoe
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testPublicationMarketDocument = `<?xml version="1.0" encoding="UTF-8"?>
+<Publication_MarketDocument xmlns="urn:iec62325.351:tc57wg16:451-3:publicationdocument:7:3">
+	<mRID>1</mRID>
+	<type>A44</type>
+	<period.timeInterval>
+		<start>2026-03-01T23:00Z</start>
+		<end>2026-03-02T01:00Z</end>
+	</period.timeInterval>
+	<TimeSeries>
+		<mRID>1</mRID>
+		<in_Domain.mRID codingScheme="A01">10YFR-RTE------C</in_Domain.mRID>
+		<curveType>A01</curveType>
+		<Period>
+			<timeInterval>
+				<start>2026-03-01T23:00Z</start>
+				<end>2026-03-02T01:00Z</end>
+			</timeInterval>
+			<resolution>PT60M</resolution>
+			<Point>
+				<position>1</position>
+				<price.amount>42.5</price.amount>
+			</Point>
+			<Point>
+				<position>2</position>
+				<price.amount>40</price.amount>
+			</Point>
+		</Period>
+	</TimeSeries>
+</Publication_MarketDocument>`
+
+func newTestClient(t *testing.T, handler http.HandlerFunc, opts ...ClientOption) *EntsoeClient {
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	return NewEntsoeClient("test-token", append([]ClientOption{WithBaseURL(srv.URL)}, opts...)...)
+}
+
+func testParams() url.Values {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypePriceDocument))
+	return params
+}
+
+func TestClientOptions(t *testing.T) {
+	var gotUserAgent, gotToken, gotDocumentType string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		gotUserAgent = r.UserAgent()
+		gotToken = r.URL.Query().Get(ParameterSecurityToken)
+		gotDocumentType = r.URL.Query().Get(ParameterDocumentType)
+		w.Write([]byte(testPublicationMarketDocument))
+	}, WithUserAgent("go-entsoe-test"), WithTimeout(5*time.Second))
+
+	doc, err := c.GetDayAheadPrices(DomainFR, genTime("202603012300"), genTime("202603020100"))
+	assert.Nil(t, err)
+	assert.NotNil(t, doc)
+	assert.Equal(t, "go-entsoe-test", gotUserAgent)
+	assert.Equal(t, "test-token", gotToken)
+	assert.Equal(t, string(DocumentTypePriceDocument), gotDocumentType)
+	assert.Len(t, doc.TimeSeries, 1)
+}
+
+func TestWithTimeoutDoesNotMutateHTTPClient(t *testing.T) {
+	hc := &http.Client{}
+	c := NewEntsoeClient("test-token", WithHTTPClient(hc), WithTimeout(time.Second))
+	assert.Equal(t, time.Duration(0), hc.Timeout)
+	assert.Equal(t, time.Second, c.httpClient.Timeout)
+}
+
+func TestGetContextCancelled(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	doc, err := c.GetDayAheadPricesContext(ctx, DomainFR, genTime("202603012300"), genTime("202603020100"))
+	assert.Nil(t, doc)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Less(t, int64(time.Since(start)), int64(time.Second))
+}
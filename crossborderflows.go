@@ -0,0 +1,152 @@
+package entsoe
+
+import (
+	"context"
+	"time"
+)
+
+// CrossBorderFlows fetches the physical flows between an area and its neighbours.
+// It is safe for concurrent use.
+type CrossBorderFlows struct {
+	client *EntsoeClient
+	area   Area
+}
+
+// FlowElement is the average flow of a 15-minute slot.
+type FlowElement struct {
+	Time    time.Time
+	Flow_MW float64
+}
+
+// NetPositionElement is the balance of the flows of an area over a 15-minute slot.
+type NetPositionElement struct {
+	Time      time.Time
+	Import_MW float64
+	Export_MW float64
+	Net_MW    float64 // Import_MW - Export_MW, positive when the area imports
+	// Complete is false when some borders have no flow for the slot,
+	// the sums then only cover the others.
+	Complete bool
+}
+
+// CrossBorderFlowsResult is the outcome of a CrossBorderFlows fetch.
+type CrossBorderFlowsResult struct {
+	// Flows holds the flows of every border of the area in both directions,
+	// on the 15-minute grid and sorted by time. Slots without a value are left out.
+	Flows map[Border][]FlowElement
+	// Net holds the net position of the area for every slot with at least one flow, sorted by time.
+	Net []NetPositionElement
+	// Errors holds the borders which failed. Their flows are missing from Net.
+	Errors map[Border]error
+}
+
+func NewCrossBorderFlows(area Area, client *EntsoeClient) (*CrossBorderFlows, error) {
+	if _, err := domain(string(area)); err != nil {
+		return nil, err
+	}
+	return &CrossBorderFlows{
+		client: client,
+		area:   area,
+	}, nil
+}
+
+// Fetch returns the flows of [from, to), at most one year: a longer window fails
+// with an error wrapping ErrInvalidParameters, without any request.
+// A failing border does not fail the others, see CrossBorderFlowsResult.Errors.
+func (f *CrossBorderFlows) Fetch(from, to time.Time) (*CrossBorderFlowsResult, error) {
+	return f.FetchContext(context.Background(), from, to)
+}
+
+// FetchContext is like Fetch but uses ctx for the requests.
+// Past the window check, the only error returned is ctx.Err(), along with what was fetched so far.
+func (f *CrossBorderFlows) FetchContext(ctx context.Context, from, to time.Time) (*CrossBorderFlowsResult, error) {
+	if err := checkWindow(from, to); err != nil {
+		return nil, err
+	}
+
+	docs, err := f.client.GetPhysicalFlowsOfAreaContext(ctx, f.area, from, to)
+	if docs == nil {
+		return nil, err
+	}
+
+	res := &CrossBorderFlowsResult{
+		Flows:  make(map[Border][]FlowElement, len(docs.Documents)),
+		Errors: docs.Errors,
+	}
+
+	flows := make(map[Border]map[int64]float64, len(docs.Documents))
+	for b, doc := range docs.Documents {
+		values, err := parseFlows(doc)
+		if err != nil {
+			logger.Error().Err(err).Str("from", string(b.From)).Str("to", string(b.To)).Msg("Error parsing physical flows")
+			res.Errors[b] = err
+			continue
+		}
+		flows[b] = values
+
+		for _, slot := range slots(from, to) {
+			if v, ok := values[slot.Unix()]; ok {
+				res.Flows[b] = append(res.Flows[b], FlowElement{Time: slot, Flow_MW: v})
+			}
+		}
+	}
+
+	res.Net = f.net(flows, from, to)
+	return res, err
+}
+
+// FetchInterval is like FetchContext but takes the window as an Interval, such as a DeliveryDay.
+func (f *CrossBorderFlows) FetchInterval(ctx context.Context, interval Interval) (*CrossBorderFlowsResult, error) {
+	return f.FetchContext(ctx, interval.Start, interval.End)
+}
+
+// net sums the imports and exports of the area for every slot of [from, to).
+func (f *CrossBorderFlows) net(flows map[Border]map[int64]float64, from, to time.Time) []NetPositionElement {
+	borders := bordersOf(f.area)
+
+	var res []NetPositionElement
+	for _, slot := range slots(from, to) {
+		elem := NetPositionElement{Time: slot, Complete: true}
+		found := false
+		for _, b := range borders {
+			v, ok := flows[b][slot.Unix()]
+			if !ok {
+				elem.Complete = false
+				continue
+			}
+			found = true
+			if b.From == f.area {
+				elem.Export_MW += v
+			} else {
+				elem.Import_MW += v
+			}
+		}
+		if !found {
+			continue
+		}
+		elem.Net_MW = elem.Import_MW - elem.Export_MW
+		res = append(res, elem)
+	}
+	return res
+}
+
+// parseFlows returns the flows of a document by 15-minute slot.
+func parseFlows(doc *PublicationMarketDocument) (map[int64]float64, error) {
+	series, err := doc.Series()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[int64]float64)
+	for _, s := range series {
+		values, ok := s.slotValues(resolution15m)
+		if !ok {
+			logger.Warn().Str("resolution", string(s.Resolution)).Msg("unsupported resolution, skipping time series")
+			continue
+		}
+		for k, v := range values {
+			res[k] = v
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,78 @@
+package entsoe
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCrossBorderFlows(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		b := Border{From: domainToArea[q.Get(ParameterOutDomain)], To: domainToArea[q.Get(ParameterInDomain)]}
+		switch b {
+		case Border{Spain, France}:
+			w.Write([]byte(testPublicationDocument(DocumentTypeAggregatedEnergyDataReport, testSeries("", "", testEnd, ResolutionHour, 1000))))
+		case Border{France, Spain}:
+			w.Write([]byte(testPublicationDocument(DocumentTypeAggregatedEnergyDataReport, testSeries("", "", testEnd, ResolutionQuarter, 0, 0, 200, 300))))
+		case Border{Spain, Portugal}:
+			w.Write([]byte(testPublicationDocument(DocumentTypeAggregatedEnergyDataReport, testSeries("", "", testEnd, ResolutionQuarter, 500, 400))))
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}, WithRetryPolicy(NoRetry), WithRateLimiter(nil))
+
+	flows, err := NewCrossBorderFlows(Spain, c)
+	assert.Nil(t, err)
+
+	res, err := flows.Fetch(genTime("202603012300"), genTime("202603020000"))
+	assert.Nil(t, err)
+
+	assert.Len(t, res.Flows, 3)
+	assert.Equal(t, []FlowElement{
+		{genTime("202603012300"), 1000},
+		{genTime("202603012315"), 1000},
+		{genTime("202603012330"), 1000},
+		{genTime("202603012345"), 1000},
+	}, res.Flows[Border{Spain, France}])
+	assert.Len(t, res.Flows[Border{Spain, Portugal}], 2)
+
+	assert.Len(t, res.Errors, 1)
+	assert.NotNil(t, res.Errors[Border{Portugal, Spain}])
+
+	if assert.Len(t, res.Net, 4) {
+		assert.Equal(t, NetPositionElement{
+			Time:      genTime("202603012300"),
+			Import_MW: 0,
+			Export_MW: 1500,
+			Net_MW:    -1500,
+		}, res.Net[0])
+		assert.Equal(t, NetPositionElement{
+			Time:      genTime("202603012345"),
+			Import_MW: 300,
+			Export_MW: 1000,
+			Net_MW:    -700,
+		}, res.Net[3])
+	}
+
+	_, err = NewCrossBorderFlows("XX", c)
+	assert.NotNil(t, err)
+}
+
+func TestCrossBorderFlowsWindow(t *testing.T) {
+	requests := 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	}, WithRetryPolicy(NoRetry), WithRateLimiter(nil))
+
+	flows, err := NewCrossBorderFlows(Spain, c)
+	assert.Nil(t, err)
+
+	_, err = flows.Fetch(genTime("202501010000"), genTime("202601010100"))
+	assert.True(t, errors.Is(err, ErrInvalidParameters), err)
+	_, err = flows.Fetch(genTime("202601010000"), genTime("202601010000"))
+	assert.True(t, errors.Is(err, ErrInvalidParameters), err)
+	assert.Zero(t, requests)
+}
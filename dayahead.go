@@ -1,16 +1,26 @@
 package entsoe
 
 import (
-	"strconv"
+	"context"
+	"sort"
+	"sync"
 	"time"
 )
 
-const resolution15m = 15 * time.Minute
+const (
+	resolution15m = 15 * time.Minute
+	chunkLength   = 30 * 24 * time.Hour
+)
 
+// DayAhead fetches and caches the day-ahead prices of an area.
+// It is safe for concurrent use.
 type DayAhead struct {
-	client     *EntsoeClient
-	domain     DomainType
-	prices     []DayAheadElement
+	client      *EntsoeClient
+	domain      DomainType
+	concurrency int
+
+	mu         sync.Mutex
+	prices     map[int64]float64 // price by slot start, in Unix seconds
 	lastUpdate time.Time
 }
 
@@ -19,136 +29,275 @@ type DayAheadElement struct {
 	Price_eur_per_MWh float64
 }
 
-func NewDayAhead(area Area, client *EntsoeClient) (*DayAhead, error) {
+// DayAheadOption configures a DayAhead.
+type DayAheadOption func(*DayAhead)
+
+// WithConcurrency sets how many 30-day chunks of a long window are fetched in parallel,
+// 1 by default. Requests still go through the rate limiter of the client.
+func WithConcurrency(n int) DayAheadOption {
+	return func(d *DayAhead) {
+		if n < 1 {
+			n = 1
+		}
+		d.concurrency = n
+	}
+}
+
+func NewDayAhead(area Area, client *EntsoeClient, opts ...DayAheadOption) (*DayAhead, error) {
 	domain, err := domain(string(area))
 	if err != nil {
 		return nil, err
 	}
 
-	return &DayAhead{
-		client:     client,
-		domain:     domain,
-		lastUpdate: time.Time{},
-	}, nil
+	d := &DayAhead{
+		client:      client,
+		domain:      domain,
+		concurrency: 1,
+		prices:      make(map[int64]float64),
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d, nil
+}
+
+// DayAheadResult is the outcome of a FetchResult call.
+type DayAheadResult struct {
+	// Prices are the 15-minute prices of the window, sorted by time.
+	Prices []DayAheadElement
+	// LastUpdate is the end of the latest period published in the fetched documents.
+	LastUpdate time.Time
+	// MissingSlots are the 15-minute slots of the window without a price,
+	// including the ones not published yet.
+	MissingSlots []time.Time
 }
 
+// Fetch returns the prices of [from, to), sorted by time.
+// If some chunks of the window fail, the prices of the others are returned
+// along with a *FetchError.
 func (d *DayAhead) Fetch(from, to time.Time) ([]DayAheadElement, error) {
-	for to.Sub(from) > 30*24*time.Hour {
-		fromChunk := to.Add(-30 * 24 * time.Hour)
-		d.fetch(fromChunk, to)
-		to = fromChunk
-	}
+	return d.FetchContext(context.Background(), from, to)
+}
 
-	d.fetch(from, to)
+// FetchContext is like Fetch but stops fetching further chunks as soon as ctx is done,
+// in which case the prices gathered so far are returned along with ctx.Err().
+func (d *DayAhead) FetchContext(ctx context.Context, from, to time.Time) ([]DayAheadElement, error) {
+	res, err := d.FetchResultContext(ctx, from, to)
+	return res.Prices, err
+}
 
-	return d.prices, nil
+// FetchInterval is like FetchContext but takes the window as an Interval, such as a DeliveryDay.
+func (d *DayAhead) FetchInterval(ctx context.Context, interval Interval) ([]DayAheadElement, error) {
+	return d.FetchContext(ctx, interval.Start, interval.End)
 }
 
-func (d *DayAhead) fetch(from, to time.Time) {
-	logger.Info().
-		Str("from", from.Format("2006-01-02")).
-		Str("to", to.Format("2006-01-02")).
-		Msg("Fetching day-ahead prices")
+// FetchResult is like Fetch but also reports the last update and the missing slots of the window.
+func (d *DayAhead) FetchResult(from, to time.Time) (*DayAheadResult, error) {
+	return d.FetchResultContext(context.Background(), from, to)
+}
 
-	doc, err := d.client.GetDayAheadPrices(d.domain, from, to)
-	if err != nil {
-		logger.Error().Err(err).Msg("Error fetching day-ahead prices")
-		return
+// chunk is one request of a fetch and its outcome.
+type chunk struct {
+	from, to   time.Time
+	done       bool
+	prices     map[int64]float64
+	lastUpdate time.Time
+	err        error
+}
+
+// splitChunks cuts [from, to) into chunks of at most chunkLength, the shortest
+// one first, in chronological order.
+func splitChunks(from, to time.Time) []*chunk {
+	var chunks []*chunk
+	end := to
+	for end.Sub(from) > chunkLength {
+		start := end.Add(-chunkLength)
+		chunks = append(chunks, &chunk{from: start, to: end})
+		end = start
 	}
+	chunks = append(chunks, &chunk{from: from, to: end})
 
-	prices, lastUpdate, err := d.parsePublicationMarketDocument(doc)
-	if err != nil {
-		logger.Error().Err(err).Msg("Error parsing publication market document")
-		return
+	for i, j := 0, len(chunks)-1; i < j; i, j = i+1, j-1 {
+		chunks[i], chunks[j] = chunks[j], chunks[i]
 	}
+	return chunks
+}
 
-	// merge into d.prices, deduplicating by timestamp
-	existing := make(map[int64]struct{}, len(d.prices))
-	for _, p := range d.prices {
-		existing[p.Time.Unix()] = struct{}{}
+// FetchResultContext is like FetchResult but uses ctx like FetchContext.
+// Chunks are fetched by a pool of WithConcurrency workers, and merged in
+// chronological order once all of them are done, whatever order they completed in.
+func (d *DayAhead) FetchResultContext(ctx context.Context, from, to time.Time) (*DayAheadResult, error) {
+	chunks := splitChunks(from, to)
+
+	workers := d.concurrency
+	if workers > len(chunks) {
+		workers = len(chunks)
 	}
-	for k, v := range prices {
-		if _, dup := existing[k]; dup {
-			for _, p := range d.prices {
-				if p.Time.Unix() == k && p.Price_eur_per_MWh != v {
-					logger.Error().
-						Time("slot", time.Unix(k, 0)).
-						Float64("existing", p.Price_eur_per_MWh).
-						Float64("conflict", v).
-						Msg("duplicate slot with different price across fetches")
+
+	jobs := make(chan *chunk)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for c := range jobs {
+				if ctx.Err() != nil {
+					continue
 				}
+				c.prices, c.lastUpdate, c.err = d.fetch(ctx, c.from, c.to)
+				c.done = true
 			}
-		} else {
-			d.prices = append(d.prices, DayAheadElement{
-				Time:              time.Unix(k, 0),
+		}()
+	}
+
+dispatch:
+	for _, c := range chunks {
+		select {
+		case jobs <- c:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var failed []*ChunkError
+	var lastUpdate time.Time
+	for _, c := range chunks {
+		if !c.done {
+			continue
+		}
+		if c.err != nil {
+			failed = append(failed, &ChunkError{From: c.from, To: c.to, Err: c.err})
+			continue
+		}
+		d.merge(c.prices)
+		if c.lastUpdate.After(lastUpdate) {
+			lastUpdate = c.lastUpdate
+		}
+	}
+	if lastUpdate.After(d.lastUpdate) {
+		d.lastUpdate = lastUpdate
+	}
+
+	res := d.result(from, to)
+	res.LastUpdate = lastUpdate
+
+	if err := ctx.Err(); err != nil {
+		return res, err
+	}
+	return res, newFetchError(failed)
+}
+
+// result returns the cached prices of [from, to) and the slots missing from them.
+// d.mu must be held.
+func (d *DayAhead) result(from, to time.Time) *DayAheadResult {
+	res := &DayAheadResult{}
+
+	for k, v := range d.prices {
+		t := time.Unix(k, 0)
+		if !t.Before(from) && t.Before(to) {
+			res.Prices = append(res.Prices, DayAheadElement{
+				Time:              t,
 				Price_eur_per_MWh: v,
 			})
-			existing[k] = struct{}{}
 		}
 	}
+	sort.Slice(res.Prices, func(i, j int) bool {
+		return res.Prices[i].Time.Before(res.Prices[j].Time)
+	})
 
-	d.lastUpdate = lastUpdate
+	for _, slot := range slots(from, to) {
+		if _, ok := d.prices[slot.Unix()]; !ok {
+			res.MissingSlots = append(res.MissingSlots, slot)
+		}
+	}
+
+	return res
 }
 
-func (d *DayAhead) parsePublicationMarketDocument(doc *PublicationMarketDocument) (map[int64]float64, time.Time, error) {
-	end, err := time.Parse("2006-01-02T15:04Z", doc.PeriodTimeInterval.End)
+// merge adds prices to the cache, the prices already cached win. d.mu must be held.
+func (d *DayAhead) merge(prices map[int64]float64) {
+	for k, v := range prices {
+		existing, dup := d.prices[k]
+		if !dup {
+			d.prices[k] = v
+			continue
+		}
+		if existing != v {
+			logger.Error().
+				Time("slot", time.Unix(k, 0)).
+				Float64("existing", existing).
+				Float64("conflict", v).
+				Msg("duplicate slot with different price across fetches")
+		}
+	}
+}
+
+// fetch returns the prices of [from, to) and the end of the published period.
+func (d *DayAhead) fetch(ctx context.Context, from, to time.Time) (map[int64]float64, time.Time, error) {
+	logger.Info().
+		Str("from", from.Format("2006-01-02")).
+		Str("to", to.Format("2006-01-02")).
+		Msg("Fetching day-ahead prices")
+
+	doc, err := d.client.GetDayAheadPricesContext(ctx, d.domain, from, to)
 	if err != nil {
 		return nil, time.Time{}, err
 	}
 
-	res := make(map[int64]float64)
+	return d.parsePublicationMarketDocument(doc)
+}
 
-	for _, timeSeries := range doc.TimeSeries {
-		period := timeSeries.Period
-		resolution := ResolutionType(period.Resolution)
-		step := durations[resolution]
+func (d *DayAhead) parsePublicationMarketDocument(doc *PublicationMarketDocument) (map[int64]float64, time.Time, error) {
+	end, err := time.Parse(timeIntervalLayout, doc.PeriodTimeInterval.End)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
 
-		start, err := time.Parse("2006-01-02T15:04Z", period.TimeInterval.Start)
-		if err != nil {
-			return nil, time.Time{}, err
-		}
+	series, err := doc.Series()
+	if err != nil {
+		return nil, time.Time{}, err
+	}
 
+	res := make(map[int64]float64)
+
+	for _, timeSeries := range series {
 		// skip secondary/local auction series (position 2+); keep SDAC (position 1 or unset)
 		// some zones (DE_LU, AT, DK2) publish both SDAC and local auction prices under A01
-		if pos := timeSeries.ClassificationSequenceAttributeInstanceComponentPosition; pos != "" && pos != "1" {
+		if pos := timeSeries.ClassificationPosition; pos != "" && pos != "1" {
 			continue
 		}
 
-		logger.Debug().
-			Str("resolution", string(resolution)).
-			Str("start", period.TimeInterval.Start).
-			Str("end", period.TimeInterval.End).
-			Int("points", len(period.Point)).
-			Int("slots_per_point", int(step/resolution15m)).
-			Msg("time series")
-
-		if step == 0 {
-			logger.Warn().Str("resolution", string(resolution)).Msg("unknown resolution, skipping time series")
+		step, err := timeSeries.Resolution.Duration()
+		if err != nil || step < resolution15m {
+			logger.Warn().Str("resolution", string(timeSeries.Resolution)).Msg("unsupported resolution, skipping time series")
 			continue
 		}
 
-		for _, point := range period.Point {
-			index, err := strconv.ParseInt(point.Position, 10, 64)
-			if err != nil {
-				return nil, time.Time{}, err
-			}
-
-			price, err := strconv.ParseFloat(point.PriceAmount, 64)
-			if err != nil {
-				return nil, time.Time{}, err
-			}
+		logger.Debug().
+			Str("resolution", string(timeSeries.Resolution)).
+			Time("start", timeSeries.Start).
+			Time("end", timeSeries.End).
+			Int("points", len(timeSeries.Points)).
+			Int("slots_per_point", int(step/resolution15m)).
+			Msg("time series")
 
-			pointTime := GetPointTime(start, int(index), resolution)
+		for _, point := range timeSeries.Points {
+			price := point.Value
 
 			// split coarser points into 15-min slots
 			slots := int(step / resolution15m)
 			for i := 0; i < slots; i++ {
-				t := pointTime.Add(time.Duration(i) * resolution15m)
+				t := point.Time.Add(time.Duration(i) * resolution15m)
 				if existing, exists := res[t.Unix()]; exists {
 					if existing != price {
 						logger.Error().
 							Time("slot", t).
-							Str("area", areaName(DomainType(timeSeries.InDomainMRID.Text))).
+							Str("area", areaName(timeSeries.InDomain)).
 							Float64("existing", existing).
 							Float64("conflict", price).
 							Msg("duplicate slot with different price")
@@ -160,38 +309,5 @@ func (d *DayAhead) parsePublicationMarketDocument(doc *PublicationMarketDocument
 		}
 	}
 
-	backfill(res)
-
 	return res, end, nil
 }
-
-func backfill(res map[int64]float64) {
-	if len(res) == 0 {
-		return
-	}
-
-	var first, last int64
-	for t := range res {
-		if first == 0 || t < first {
-			first = t
-		}
-		if t > last {
-			last = t
-		}
-	}
-
-	step := int64(resolution15m.Seconds())
-	var prevPrice float64
-
-	for t := first; t <= last; t += step {
-		if price, ok := res[t]; ok {
-			prevPrice = price
-		} else {
-			logger.Debug().
-				Time("slot", time.Unix(t, 0)).
-				Float64("backfill_price", prevPrice).
-				Msg("missing slot, backfilling with previous price")
-			res[t] = prevPrice
-		}
-	}
-}
@@ -0,0 +1,125 @@
+package entsoe
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDayAheadFetchResult(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(testMultiPeriodPublicationMarketDocument))
+	})
+
+	dayAhead, err := NewDayAhead(France, c)
+	assert.Nil(t, err)
+
+	res, err := dayAhead.FetchResult(genTime("202603020000"), genTime("202603020215"))
+	assert.Nil(t, err)
+
+	var times []time.Time
+	for _, p := range res.Prices {
+		times = append(times, p.Time.UTC())
+	}
+	assert.Equal(t, []time.Time{
+		genTime("202603020000"),
+		genTime("202603020015"),
+		genTime("202603020030"),
+		genTime("202603020045"),
+		genTime("202603020200"),
+	}, times)
+	assert.Equal(t, []time.Time{
+		genTime("202603020100"),
+		genTime("202603020115"),
+		genTime("202603020130"),
+		genTime("202603020145"),
+	}, res.MissingSlots)
+	assert.Equal(t, genTime("202603020300"), res.LastUpdate)
+}
+
+func TestDayAheadFetchChunkErrors(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get(ParameterPeriodStart) == "202601012300" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(testAcknowledgement("The combination of [In_Domain, Out_Domain] is not valid")))
+			return
+		}
+		w.Write([]byte(testPublicationMarketDocument))
+	}, WithRetryPolicy(NoRetry))
+
+	dayAhead, err := NewDayAhead(France, c)
+	assert.Nil(t, err)
+
+	// two 30-day chunks, the earliest one fails
+	prices, err := dayAhead.Fetch(genTime("202601012300"), genTime("202603022300"))
+	assert.Len(t, prices, 8)
+	assert.True(t, errors.Is(err, ErrInvalidParameters))
+
+	var fetchErr *FetchError
+	if assert.True(t, errors.As(err, &fetchErr)) {
+		assert.Len(t, fetchErr.Chunks, 1)
+		assert.Equal(t, genTime("202601012300"), fetchErr.Chunks[0].From)
+		assert.Equal(t, genTime("202601312300"), fetchErr.Chunks[0].To)
+	}
+
+	var apiErr *APIError
+	assert.True(t, errors.As(err, &apiErr))
+}
+
+func TestDayAheadConcurrentChunks(t *testing.T) {
+	var mu sync.Mutex
+	inFlight, maxInFlight, requests := 0, 0, 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		requests++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+
+		time.Sleep(20 * time.Millisecond)
+		w.Write([]byte(testPublicationMarketDocument))
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+	}, WithRateLimiter(nil))
+
+	dayAhead, err := NewDayAhead(France, c, WithConcurrency(3))
+	assert.Nil(t, err)
+
+	// five 30-day chunks, fetched concurrently by two goroutines sharing the DayAhead
+	var wg sync.WaitGroup
+	results := make([][]DayAheadElement, 2)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			prices, err := dayAhead.Fetch(genTime("202511012300"), genTime("202603022300"))
+			assert.Nil(t, err)
+			results[i] = prices
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, 10, requests)
+	assert.True(t, maxInFlight > 1)
+	assert.True(t, maxInFlight <= 6)
+	assert.Len(t, results[0], 8)
+	assert.Equal(t, results[0], results[1])
+}
+
+func TestSplitChunks(t *testing.T) {
+	chunks := splitChunks(genTime("202601012300"), genTime("202603022300"))
+	if assert.Len(t, chunks, 2) {
+		assert.Equal(t, genTime("202601012300"), chunks[0].from)
+		assert.Equal(t, genTime("202601312300"), chunks[0].to)
+		assert.Equal(t, genTime("202601312300"), chunks[1].from)
+		assert.Equal(t, genTime("202603022300"), chunks[1].to)
+	}
+}
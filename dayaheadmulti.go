@@ -0,0 +1,128 @@
+package entsoe
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// DayAheadMulti fetches the day-ahead prices of several areas side by side.
+// It is safe for concurrent use.
+type DayAheadMulti struct {
+	areas     []Area
+	dayAheads map[Area]*DayAhead
+	errors    map[Area]error // areas without a DayAhead
+}
+
+// DayAheadMatrix holds the prices of several areas on a common 15-minute grid.
+type DayAheadMatrix struct {
+	// Areas lists the areas in the order they were requested.
+	Areas []Area
+	// Times are the 15-minute slots of the window, sorted.
+	Times []time.Time
+	// Prices holds a row per area, aligned with Times. Missing prices are NaN.
+	Prices map[Area][]float64
+	// Errors holds the areas which failed, completely or for some chunks.
+	// The prices fetched for them are still in Prices.
+	Errors map[Area]error
+}
+
+// NewDayAheadMulti prepares the fetching of the given areas. Unknown areas are
+// not rejected here, they are reported in the Errors of every fetch.
+// The options apply to the DayAhead of every area.
+func NewDayAheadMulti(areas []Area, client *EntsoeClient, opts ...DayAheadOption) *DayAheadMulti {
+	m := &DayAheadMulti{
+		dayAheads: make(map[Area]*DayAhead, len(areas)),
+		errors:    make(map[Area]error),
+	}
+	for _, area := range areas {
+		if _, seen := m.dayAheads[area]; seen {
+			continue
+		}
+		if _, seen := m.errors[area]; seen {
+			continue
+		}
+		m.areas = append(m.areas, area)
+
+		dayAhead, err := NewDayAhead(area, client, opts...)
+		if err != nil {
+			m.errors[area] = err
+			continue
+		}
+		m.dayAheads[area] = dayAhead
+	}
+	return m
+}
+
+// Fetch returns the prices of every area for [from, to).
+// A failing area does not fail the others, see DayAheadMatrix.Errors.
+func (m *DayAheadMulti) Fetch(from, to time.Time) (*DayAheadMatrix, error) {
+	return m.FetchContext(context.Background(), from, to)
+}
+
+// FetchContext is like Fetch but uses ctx for the requests of every area.
+// The only error returned is ctx.Err(), along with what was fetched so far.
+func (m *DayAheadMulti) FetchContext(ctx context.Context, from, to time.Time) (*DayAheadMatrix, error) {
+	matrix := &DayAheadMatrix{
+		Areas:  m.areas,
+		Times:  slots(from, to),
+		Prices: make(map[Area][]float64, len(m.areas)),
+		Errors: make(map[Area]error),
+	}
+
+	index := make(map[int64]int, len(matrix.Times))
+	for i, t := range matrix.Times {
+		index[t.Unix()] = i
+	}
+
+	for _, area := range m.areas {
+		row := make([]float64, len(matrix.Times))
+		for i := range row {
+			row[i] = math.NaN()
+		}
+		matrix.Prices[area] = row
+
+		if err, ok := m.errors[area]; ok {
+			matrix.Errors[area] = err
+		}
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for area, dayAhead := range m.dayAheads {
+		wg.Add(1)
+		go func(area Area, dayAhead *DayAhead, row []float64) {
+			defer wg.Done()
+
+			prices, err := dayAhead.FetchContext(ctx, from, to)
+			for _, p := range prices {
+				if i, ok := index[p.Time.Unix()]; ok {
+					row[i] = p.Price_eur_per_MWh
+				}
+			}
+			if err != nil {
+				logger.Error().Err(err).Str("area", string(area)).Msg("Error fetching day-ahead prices")
+				mu.Lock()
+				matrix.Errors[area] = err
+				mu.Unlock()
+			}
+		}(area, dayAhead, matrix.Prices[area])
+	}
+	wg.Wait()
+
+	return matrix, ctx.Err()
+}
+
+// slots returns the 15-minute slots starting in [from, to).
+func slots(from, to time.Time) []time.Time {
+	var res []time.Time
+	slot := from.Truncate(resolution15m)
+	if slot.Before(from) {
+		slot = slot.Add(resolution15m)
+	}
+	for ; slot.Before(to); slot = slot.Add(resolution15m) {
+		res = append(res, slot)
+	}
+	return res
+}
@@ -0,0 +1,44 @@
+package entsoe
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDayAheadMulti(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get(ParameterInDomain) {
+		case DomainFR:
+			w.Write([]byte(testPublicationMarketDocument))
+		case DomainBE:
+			w.Write([]byte(strings.Replace(testPublicationMarketDocument, "<price.amount>40</price.amount>", "<price.amount>41</price.amount>", 1)))
+		default:
+			w.Write([]byte(testAcknowledgement("No matching data found for Data item Day-ahead Prices [12.1.D]")))
+		}
+	}, WithRetryPolicy(NoRetry))
+
+	m := NewDayAheadMulti([]Area{France, Belgium, Netherlands, "XX", France}, c)
+	matrix, err := m.Fetch(genTime("202603012330"), genTime("202603020100"))
+	assert.Nil(t, err)
+
+	assert.Equal(t, []Area{France, Belgium, Netherlands, "XX"}, matrix.Areas)
+	assert.Len(t, matrix.Times, 6)
+	assert.Equal(t, genTime("202603012330"), matrix.Times[0])
+	assert.Equal(t, genTime("202603020045"), matrix.Times[5])
+
+	assert.Equal(t, []float64{42.5, 42.5, 40, 40, 40, 40}, matrix.Prices[France])
+	assert.Equal(t, []float64{42.5, 42.5, 41, 41, 41, 41}, matrix.Prices[Belgium])
+	for _, area := range []Area{Netherlands, "XX"} {
+		assert.Len(t, matrix.Prices[area], 6)
+		assert.True(t, math.IsNaN(matrix.Prices[area][0]))
+	}
+
+	assert.Len(t, matrix.Errors, 2)
+	assert.True(t, errors.Is(matrix.Errors[Netherlands], ErrNoMatchingData))
+	assert.NotNil(t, matrix.Errors["XX"])
+}
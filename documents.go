@@ -41,7 +41,7 @@ type PublicationMarketDocument struct {
 		CurrencyUnitName     string `xml:"currency_Unit.name"`      // EUR, EUR, EUR, EUR, EUR, ...
 		PriceMeasureUnitName string `xml:"price_Measure_Unit.name"` // MWH, MWH, MWH, MWH, MWH, ...
 		CurveType            string `xml:"curveType"`               // A01, A01, A01, A01, A01, ...
-		Period               struct {
+		Period               []struct {
 			Text         string `xml:",chardata"`
 			TimeInterval struct {
 				Text  string `xml:",chardata"`
@@ -90,7 +90,7 @@ type CriticalNetworkElementMarketDocument struct {
 		MRID         string `xml:"mRID"`         // 1, 2
 		BusinessType string `xml:"businessType"` // B39, B39
 		CurveType    string `xml:"curveType"`    // A01, A01
-		Period       struct {
+		Period       []struct {
 			Text         string `xml:",chardata"`
 			TimeInterval struct {
 				Text  string `xml:",chardata"`
@@ -161,7 +161,7 @@ type TransmissionNetworkMarketDocument struct {
 			CodingScheme string `xml:"codingScheme,attr"`
 		} `xml:"out_Domain.mRID"`
 		CurveType string `xml:"curveType"` // A01, A01, A01, A01, A01, ...
-		Period    struct {
+		Period    []struct {
 			Text         string `xml:",chardata"`
 			TimeInterval struct {
 				Text  string `xml:",chardata"`
@@ -169,7 +169,7 @@ type TransmissionNetworkMarketDocument struct {
 				End   string `xml:"end"`   // 2016-02-01T00:00Z, 2016-0...
 			} `xml:"timeInterval"`
 			Resolution string `xml:"resolution"` // P1M, P1M, P1M, P1M, P1M, ...
-			Point      struct {
+			Point      []struct {
 				Text     string `xml:",chardata"`
 				Position string `xml:"position"` // 1, 1, 1, 1, 1, 1, 1, 1, 1...
 			} `xml:"Point"`
@@ -213,7 +213,7 @@ type BalancingMarketDocument struct {
 		FlowDirectionDirection  string `xml:"flowDirection.direction"`    // A02, A02, A01, A01, A02, ...
 		QuantityMeasureUnitName string `xml:"quantity_Measure_Unit.name"` // MAW, MWH, MWH, MWH, MWH, ...
 		CurveType               string `xml:"curveType"`                  // A01, A01, A01, A01, A01, ...
-		Period                  struct {
+		Period                  []struct {
 			Text         string `xml:",chardata"`
 			TimeInterval struct {
 				Text  string `xml:",chardata"`
@@ -252,80 +252,6 @@ type BalancingMarketDocument struct {
 	} `xml:"controlArea_Domain.mRID"`
 }
 
-// UnavailabilityMarketDocument was generated 2024-02-29 09:51:34.
-type UnavailabilityMarketDocument struct {
-	XMLName                     xml.Name `xml:"Unavailability_MarketDocument"`
-	Text                        string   `xml:",chardata"`
-	Xmlns                       string   `xml:"xmlns,attr"`
-	MRID                        string   `xml:"mRID"`                // kjfQMlmtlZVC32VliNsNQg, -...
-	RevisionNumber              string   `xml:"revisionNumber"`      // 2, 1, 3, 3, 3, 3, 3, 2, 2...
-	Type                        string   `xml:"type"`                // A79, A79, A79, A79, A79, ...
-	ProcessProcessType          string   `xml:"process.processType"` // A26, A26, A26, A26, A26, ...
-	CreatedDateTime             string   `xml:"createdDateTime"`     // 2016-05-13T06:19:51Z, 201...
-	SenderMarketParticipantMRID struct {
-		Text         string `xml:",chardata"` // 10X1001A1001A450, 10X1001...
-		CodingScheme string `xml:"codingScheme,attr"`
-	} `xml:"sender_MarketParticipant.mRID"`
-	SenderMarketParticipantMarketRoleType string `xml:"sender_MarketParticipant.marketRole.type"` // A32, A32, A32, A32, A32, ...
-	ReceiverMarketParticipantMRID         struct {
-		Text         string `xml:",chardata"` // 10X1001A1001A450, 10X1001...
-		CodingScheme string `xml:"codingScheme,attr"`
-	} `xml:"receiver_MarketParticipant.mRID"`
-	ReceiverMarketParticipantMarketRoleType string `xml:"receiver_MarketParticipant.marketRole.type"` // A33, A33, A33, A33, A33, ...
-	UnavailabilityTimePeriodTimeInterval    struct {
-		Text  string `xml:",chardata"`
-		Start string `xml:"start"` // 2015-11-23T17:50Z, 2015-1...
-		End   string `xml:"end"`   // 2016-05-12T19:51Z, 2016-0...
-	} `xml:"unavailability_Time_Period.timeInterval"`
-	TimeSeries struct {
-		Text                  string `xml:",chardata"`
-		MRID                  string `xml:"mRID"`         // 1, 1, 1, 1, 1, 1, 1, 1, 1...
-		BusinessType          string `xml:"businessType"` // A54, A54, A54, A54, A54, ...
-		BiddingZoneDomainMRID struct {
-			Text         string `xml:",chardata"` // 10YDE-EON------1, 10YDE-E...
-			CodingScheme string `xml:"codingScheme,attr"`
-		} `xml:"biddingZone_Domain.mRID"`
-		StartDateAndOrTimeDate                                          string `xml:"start_DateAndOrTime.date"`   // 2015-11-23, 2015-12-29, 2...
-		StartDateAndOrTimeTime                                          string `xml:"start_DateAndOrTime.time"`   // 17:50:00Z, 19:43:00Z, 07:...
-		EndDateAndOrTimeDate                                            string `xml:"end_DateAndOrTime.date"`     // 2016-05-12, 2016-01-05, 2...
-		EndDateAndOrTimeTime                                            string `xml:"end_DateAndOrTime.time"`     // 19:51:00Z, 19:43:00Z, 16:...
-		QuantityMeasureUnitName                                         string `xml:"quantity_Measure_Unit.name"` // MAW, MAW, MAW, MAW, MAW, ...
-		CurveType                                                       string `xml:"curveType"`                  // A03, A03, A03, A03, A03, ...
-		ProductionRegisteredResourcePSRTypePowerSystemResourcesNominalP struct {
-			Text string `xml:",chardata"` // 113, 156, 864, 144, 144, ...
-			Unit string `xml:"unit,attr"`
-		} `xml:"production_RegisteredResource.pSRType.powerSystemResources.nominalP"`
-		AssetRegisteredResource struct {
-			Text string `xml:",chardata"`
-			MRID struct {
-				Text         string `xml:",chardata"` // 11TD2L000000267O, 11TD2L0...
-				CodingScheme string `xml:"codingScheme,attr"`
-			} `xml:"mRID"`
-			Name                string `xml:"name"`                  // L-155-RIFF-EMDB-AC114, L-...
-			AssetPSRTypePsrType string `xml:"asset_PSRType.psrType"` // B21, B21, B23, B21, B21, ...
-			LocationName        string `xml:"location.name"`         // Riffgat-Emden/Borssum, Bo...
-		} `xml:"Asset_RegisteredResource"`
-		WindPowerFeedinPeriod struct {
-			Text         string `xml:",chardata"`
-			TimeInterval struct {
-				Text  string `xml:",chardata"`
-				Start string `xml:"start"` // 2015-11-23T17:50Z, 2015-1...
-				End   string `xml:"end"`   // 2016-05-12T19:51Z, 2016-0...
-			} `xml:"timeInterval"`
-			Resolution string `xml:"resolution"` // PT1M, PT1M, PT1M, PT1M, P...
-			Point      struct {
-				Text     string `xml:",chardata"`
-				Position string `xml:"position"` // 1, 1, 1, 1, 1, 1, 1, 1, 1...
-				Quantity string `xml:"quantity"` // 0, 80, 545, 142, 141, 141...
-			} `xml:"Point"`
-		} `xml:"WindPowerFeedin_Period"`
-	} `xml:"TimeSeries"`
-	Reason struct {
-		Text string `xml:",chardata"`
-		Code string `xml:"code"` // B18, B18, B18, B18, B18, ...
-	} `xml:"Reason"`
-}
-
 // GLMarketDocument was generated 2024-02-29 09:51:35.
 type GLMarketDocument struct {
 	XMLName                     xml.Name `xml:"GL_MarketDocument"`
@@ -362,7 +288,7 @@ type GLMarketDocument struct {
 		} `xml:"outBiddingZone_Domain.mRID"`
 		QuantityMeasureUnitName string `xml:"quantity_Measure_Unit.name"` // MAW, MAW, MAW, MAW, MAW, ...
 		CurveType               string `xml:"curveType"`                  // A01, A01, A01, A01, A01, ...
-		Period                  struct {
+		Period                  []struct {
 			Text         string `xml:",chardata"`
 			TimeInterval struct {
 				Text  string `xml:",chardata"`
@@ -422,7 +348,7 @@ type AcknowledgementMarketDocument struct {
 	} `xml:"receiver_MarketParticipant.mRID"`
 	ReceiverMarketParticipantMarketRoleType string `xml:"receiver_MarketParticipant.marketRole.type"` // A39, A39, A39, A39, A39, ...
 	ReceivedMarketDocumentCreatedDateTime   string `xml:"received_MarketDocument.createdDateTime"`    // 2024-02-29T08:42:48Z, 202...
-	Reason                                  struct {
+	Reason                                  []struct {
 		Chardata string `xml:",chardata"`
 		Code     string `xml:"code"` // 999, 999, 999, 999, 999, ...
 		Text     string `xml:"text"` // No matching data found fo...
@@ -0,0 +1,291 @@
+package entsoe
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// EIC describes an area EIC code of the registry.
+type EIC struct {
+	Code      DomainType
+	Name      string
+	AreaTypes []AreaType
+	Country   string    // ISO 3166 code, empty for regions spanning several countries
+	ValidFrom time.Time // zero when valid since before the platform opened
+	ValidTo   time.Time // zero when still valid
+}
+
+// HasType reports whether the area is of the given type.
+func (e EIC) HasType(areaType AreaType) bool {
+	for _, t := range e.AreaTypes {
+		if t == areaType {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidAt reports whether the code is in use at t.
+func (e EIC) ValidAt(t time.Time) bool {
+	return (e.ValidFrom.IsZero() || !t.Before(e.ValidFrom)) && (e.ValidTo.IsZero() || t.Before(e.ValidTo))
+}
+
+// LookupEIC returns the registry entry of an area code.
+func LookupEIC(code DomainType) (EIC, bool) {
+	e, ok := eics[code]
+	if !ok {
+		return EIC{}, false
+	}
+	e.Code = code
+	e.AreaTypes = append([]AreaType(nil), e.AreaTypes...)
+	return e, true
+}
+
+// ValidEIC reports whether code is a 16 character EIC code with a valid check character.
+func ValidEIC(code string) bool {
+	if len(code) != 16 {
+		return false
+	}
+	sum := 0
+	for i := 0; i < 15; i++ {
+		v, ok := eicValue(code[i])
+		if !ok {
+			return false
+		}
+		sum += v * (16 - i)
+	}
+	return code[15] == eicCheckCharacter(sum)
+}
+
+// eicValue maps 0-9 to 0-9, A-Z to 10-35 and - to 36.
+func eicValue(c byte) (int, bool) {
+	switch {
+	case c >= '0' && c <= '9':
+		return int(c - '0'), true
+	case c >= 'A' && c <= 'Z':
+		return int(c-'A') + 10, true
+	case c == '-':
+		return 36, true
+	}
+	return 0, false
+}
+
+func eicCheckCharacter(sum int) byte {
+	v := 36 - (sum-1)%37
+	switch {
+	case v < 10:
+		return byte('0' + v)
+	case v < 36:
+		return byte('A' + v - 10)
+	}
+	// 36 would be '-', which the standard forbids as check character
+	return '-'
+}
+
+// domainRoles lists the area types accepted by the domain parameters.
+var domainRoles = map[string][]AreaType{
+	ParameterBiddingZoneDomain:    {BZN, CTA},
+	ParameterOutBiddingZoneDomain: {BZN, CTA, CTY},
+	ParameterControlAreaDomain:    {CTA, MBA, SCA, IBA, IPA},
+	ParameterAreaDomain:           {CTA, MBA, SCA, IBA, IPA, LFA, LFB, SNA, BZN, REG},
+	ParameterInDomain:             {BZN, BZA, CTA, CTY, MBA, REG},
+	ParameterOutDomain:            {BZN, BZA, CTA, CTY, MBA, REG},
+	ParameterAcquiringDomain:      {CTA, MBA, SCA, LFA, LFB, BZN},
+	ParameterConnectingDomain:     {CTA, MBA, SCA, LFA, LFB, BZN},
+}
+
+// documentDomainRoles narrows domainRoles for some document types.
+var documentDomainRoles = map[DocumentType]map[string][]AreaType{
+	DocumentTypePriceDocument: {
+		ParameterInDomain:  {BZN},
+		ParameterOutDomain: {BZN},
+	},
+}
+
+// validateDomains checks the domain parameters of a request against the registry,
+// so that a misused code fails before reaching the platform.
+// Codes missing from the registry only need a valid check character.
+func validateDomains(params url.Values) error {
+	overrides := documentDomainRoles[DocumentType(params.Get(ParameterDocumentType))]
+	for param, roles := range domainRoles {
+		code := params.Get(param)
+		if code == "" {
+			continue
+		}
+		if r, ok := overrides[param]; ok {
+			roles = r
+		}
+
+		e, ok := eics[code]
+		if !ok {
+			if !ValidEIC(code) {
+				return fmt.Errorf("%w: %s %s is not a valid EIC code", ErrInvalidParameters, param, code)
+			}
+			continue
+		}
+
+		valid := false
+		for _, role := range roles {
+			if e.HasType(role) {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return fmt.Errorf("%w: %s %s (%s) is not a %s", ErrInvalidParameters, param, code, e.Name, joinAreaTypes(roles))
+		}
+	}
+	return nil
+}
+
+func joinAreaTypes(types []AreaType) string {
+	s := ""
+	for i, t := range types {
+		switch {
+		case i == 0:
+		case i == len(types)-1:
+			s += " or "
+		default:
+			s += ", "
+		}
+		s += string(t)
+	}
+	return s
+}
+
+func utcDate(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+var (
+	// zone is the area types of the code of a country with a single bidding zone.
+	zone = []AreaType{BZN, CTA, MBA, SCA, IPA, CTY}
+	// bzn is the area types of a bidding zone within a country.
+	bzn = []AreaType{BZN, MBA, SCA, IPA}
+	// tso is the area types of the control area of a TSO within a country.
+	tso = []AreaType{CTA, SCA, LFA}
+)
+
+// eics is the registry of the area codes of types.go.
+// Start and end of validity are CET midnights, in UTC.
+var eics = map[DomainType]EIC{
+	DomainNIR:               {Name: "Northern Ireland (SONI)", Country: "GB", AreaTypes: tso},
+	DomainEE:                {Name: "Estonia", Country: "EE", AreaTypes: zone},
+	DomainSE1:               {Name: "SE1", Country: "SE", AreaTypes: bzn},
+	DomainSE2:               {Name: "SE2", Country: "SE", AreaTypes: bzn},
+	DomainSE3:               {Name: "SE3", Country: "SE", AreaTypes: bzn},
+	DomainSE4:               {Name: "SE4", Country: "SE", AreaTypes: bzn},
+	DomainNO5:               {Name: "NO5", Country: "NO", AreaTypes: bzn},
+	DomainRussianArea:       {Name: "Russia", Country: "RU", AreaTypes: []AreaType{BZN, CTA, MBA}},
+	DomainRUKGD:             {Name: "Kaliningrad", Country: "RU", AreaTypes: []AreaType{BZN, CTA, MBA}},
+	DomainBelarusArea:       {Name: "Belarus", Country: "BY", AreaTypes: []AreaType{BZN, CTA, MBA}},
+	DomainIESEM:             {Name: "Ireland (SEM)", Country: "IE", AreaTypes: bzn, ValidFrom: utcDate(2018, 9, 30).Add(22 * time.Hour)},
+	DomainDEATLU:            {Name: "DE-AT-LU", AreaTypes: []AreaType{BZN, MBA}, ValidTo: utcDate(2018, 9, 30).Add(22 * time.Hour)},
+	DomainNO1A:              {Name: "NO1A", Country: "NO", AreaTypes: []AreaType{BZN}},
+	DomainDK:                {Name: "Denmark", Country: "DK", AreaTypes: []AreaType{CTY}},
+	DomainITGR:              {Name: "IT-GR", Country: "IT", AreaTypes: []AreaType{BZN}},
+	DomainITNorthSI:         {Name: "IT-North-SI", Country: "IT", AreaTypes: []AreaType{BZN}},
+	DomainITNorthCH:         {Name: "IT-North-CH", Country: "IT", AreaTypes: []AreaType{BZN}},
+	DomainITBrindisi:        {Name: "IT-Brindisi", Country: "IT", AreaTypes: []AreaType{BZN}, ValidTo: utcDate(2020, 12, 31).Add(23 * time.Hour)},
+	DomainITZCentreNorth:    {Name: "IT-Centre-North", Country: "IT", AreaTypes: bzn},
+	DomainITCentreSouth:     {Name: "IT-Centre-South", Country: "IT", AreaTypes: bzn},
+	DomainITZFoggia:         {Name: "IT-Foggia", Country: "IT", AreaTypes: []AreaType{BZN}, ValidTo: utcDate(2020, 12, 31).Add(23 * time.Hour)},
+	DomainITNorth:           {Name: "IT-North", Country: "IT", AreaTypes: bzn},
+	DomainITZSardinia:       {Name: "IT-Sardinia", Country: "IT", AreaTypes: bzn},
+	DomainITSicily:          {Name: "IT-Sicily", Country: "IT", AreaTypes: bzn},
+	DomainITZPriolo:         {Name: "IT-Priolo", Country: "IT", AreaTypes: []AreaType{BZN}, ValidTo: utcDate(2020, 12, 31).Add(23 * time.Hour)},
+	DomainITRossano:         {Name: "IT-Rossano", Country: "IT", AreaTypes: []AreaType{BZN}, ValidTo: utcDate(2020, 12, 31).Add(23 * time.Hour)},
+	DomainITZSouth:          {Name: "IT-South", Country: "IT", AreaTypes: bzn},
+	DomainCADenmark:         {Name: "Denmark (Energinet)", Country: "DK", AreaTypes: []AreaType{CTA}},
+	DomainITNorthAT:         {Name: "IT-North-AT", Country: "IT", AreaTypes: []AreaType{BZN}},
+	DomainITNorthFR:         {Name: "IT-North-FR", Country: "IT", AreaTypes: []AreaType{BZN}},
+	DomainDELU:              {Name: "DE-LU", Country: "DE", AreaTypes: bzn, ValidFrom: utcDate(2018, 9, 30).Add(22 * time.Hour)},
+	DomainDE:                {Name: "Germany", Country: "DE", AreaTypes: []AreaType{CTY}},
+	DomainITMACRZONENORTH:   {Name: "IT-Macrozone North", Country: "IT", AreaTypes: []AreaType{MBA}},
+	DomainITMACRZONESOUTH:   {Name: "IT-Macrozone South", Country: "IT", AreaTypes: []AreaType{MBA}},
+	DomainUADobTPP:          {Name: "Ukraine-DobTPP", Country: "UA", AreaTypes: []AreaType{BZN, CTA}},
+	DomainITMalta:           {Name: "IT-Malta", Country: "IT", AreaTypes: []AreaType{BZN}},
+	DomainITSACOAC:          {Name: "IT-SACO AC", Country: "IT", AreaTypes: []AreaType{BZN}},
+	DomainITSACODC:          {Name: "IT-SACO DC", Country: "IT", AreaTypes: []AreaType{BZN}},
+	DomainNordic:            {Name: "Nordic", AreaTypes: []AreaType{LFB, SNA, REG}},
+	DomainUK:                {Name: "United Kingdom", Country: "GB", AreaTypes: []AreaType{CTY}},
+	DomainMT:                {Name: "Malta", Country: "MT", AreaTypes: zone},
+	DomainMD:                {Name: "Moldova", Country: "MD", AreaTypes: zone},
+	DomainAM:                {Name: "Armenia", Country: "AM", AreaTypes: zone},
+	DomainGE:                {Name: "Georgia", Country: "GE", AreaTypes: zone},
+	DomainAZ:                {Name: "Azerbaijan", Country: "AZ", AreaTypes: zone},
+	DomainUA:                {Name: "Ukraine", Country: "UA", AreaTypes: []AreaType{BZN, MBA, CTY}},
+	DomainUAIPS:             {Name: "Ukraine IPS", Country: "UA", AreaTypes: []AreaType{BZN, CTA, MBA, SCA}},
+	DomainCZDESKLTSE4:       {Name: "CZ+DE+SK+LT+SE4", AreaTypes: []AreaType{BZA}},
+	DomainCORE:              {Name: "Core", AreaTypes: []AreaType{REG}},
+	DomainAFRR:              {Name: "aFRR region", AreaTypes: []AreaType{REG}},
+	DomainSWE:               {Name: "South West Europe", AreaTypes: []AreaType{REG}},
+	DomainITCalabria:        {Name: "IT-Calabria", Country: "IT", AreaTypes: bzn, ValidFrom: utcDate(2020, 12, 31).Add(23 * time.Hour)},
+	DomainGBIFA:             {Name: "GB(IFA)", Country: "GB", AreaTypes: []AreaType{BZN}},
+	DomainXK:                {Name: "Kosovo", Country: "XK", AreaTypes: zone},
+	DomainIN:                {Name: "IN region", AreaTypes: []AreaType{REG}},
+	DomainNO2A:              {Name: "NO2A", Country: "NO", AreaTypes: []AreaType{BZN}},
+	DomainITALYNORTH:        {Name: "Italy North", AreaTypes: []AreaType{REG}},
+	DomainGRIT:              {Name: "Greece-Italy", AreaTypes: []AreaType{REG}},
+	DomainAL:                {Name: "Albania", Country: "AL", AreaTypes: zone},
+	DomainAT:                {Name: "Austria", Country: "AT", AreaTypes: zone},
+	DomainBA:                {Name: "Bosnia and Herzegovina", Country: "BA", AreaTypes: zone},
+	DomainBE:                {Name: "Belgium", Country: "BE", AreaTypes: zone},
+	DomainBG:                {Name: "Bulgaria", Country: "BG", AreaTypes: zone},
+	DomainDEDK1LU:           {Name: "DE-DK1-LU", AreaTypes: []AreaType{LFB}},
+	DomainCBRSMKME:          {Name: "RS-MK-ME", AreaTypes: []AreaType{LFB}},
+	DomainCBPL:              {Name: "Poland", Country: "PL", AreaTypes: []AreaType{LFB}},
+	DomainCBSIHRBA:          {Name: "SI-HR-BA", AreaTypes: []AreaType{LFB}},
+	DomainCH:                {Name: "Switzerland", Country: "CH", AreaTypes: zone},
+	DomainME:                {Name: "Montenegro", Country: "ME", AreaTypes: zone},
+	DomainRS:                {Name: "Serbia", Country: "RS", AreaTypes: zone},
+	DomainCY:                {Name: "Cyprus", Country: "CY", AreaTypes: zone},
+	DomainCZ:                {Name: "Czechia", Country: "CZ", AreaTypes: zone},
+	DomainDETransnetBW:      {Name: "TransnetBW", Country: "DE", AreaTypes: tso},
+	DomainDETenneTGER:       {Name: "TenneT GER", Country: "DE", AreaTypes: tso},
+	DomainDEAmprion:         {Name: "Amprion", Country: "DE", AreaTypes: tso},
+	DomainDE50Hertz:         {Name: "50Hertz", Country: "DE", AreaTypes: tso},
+	DomainDK1A:              {Name: "DK1A", Country: "DK", AreaTypes: []AreaType{BZN}},
+	DomainDK1:               {Name: "DK1", Country: "DK", AreaTypes: []AreaType{BZN, MBA, SCA, IPA, LFA}},
+	DomainDK2:               {Name: "DK2", Country: "DK", AreaTypes: []AreaType{BZN, MBA, SCA, IPA, LFA}},
+	DomainPLCZ:              {Name: "PL-CZ", AreaTypes: []AreaType{BZA, CTA}},
+	DomainCZDESK:            {Name: "CZ+DE+SK", AreaTypes: []AreaType{BZA}},
+	DomainLTSE4:             {Name: "PL+SE4+LT", AreaTypes: []AreaType{BZA}},
+	DomainCWE:               {Name: "Central Western Europe", AreaTypes: []AreaType{REG}},
+	DomainES:                {Name: "Spain", Country: "ES", AreaTypes: zone},
+	DomainContinentalEurope: {Name: "Continental Europe", AreaTypes: []AreaType{SNA}},
+	DomainFI:                {Name: "Finland", Country: "FI", AreaTypes: zone},
+	DomainFR:                {Name: "France", Country: "FR", AreaTypes: zone},
+	DomainGB:                {Name: "Great Britain", Country: "GB", AreaTypes: []AreaType{BZN, CTA, MBA, SCA, IPA}},
+	DomainGR:                {Name: "Greece", Country: "GR", AreaTypes: zone},
+	DomainHR:                {Name: "Croatia", Country: "HR", AreaTypes: zone},
+	DomainHU:                {Name: "Hungary", Country: "HU", AreaTypes: zone},
+	DomainIE:                {Name: "Ireland (EirGrid)", Country: "IE", AreaTypes: []AreaType{CTA, SCA, CTY}},
+	DomainIT:                {Name: "Italy", Country: "IT", AreaTypes: []AreaType{CTA, SCA, CTY}},
+	DomainLT:                {Name: "Lithuania", Country: "LT", AreaTypes: zone},
+	DomainLU:                {Name: "Luxembourg", Country: "LU", AreaTypes: []AreaType{CTA, CTY}},
+	DomainLV:                {Name: "Latvia", Country: "LV", AreaTypes: zone},
+	DomainMK:                {Name: "North Macedonia", Country: "MK", AreaTypes: zone},
+	DomainNL:                {Name: "Netherlands", Country: "NL", AreaTypes: zone},
+	DomainNO:                {Name: "Norway", Country: "NO", AreaTypes: []AreaType{CTA, MBA, CTY}},
+	DomainNO1:               {Name: "NO1", Country: "NO", AreaTypes: bzn},
+	DomainNO2:               {Name: "NO2", Country: "NO", AreaTypes: bzn},
+	DomainNO3:               {Name: "NO3", Country: "NO", AreaTypes: bzn},
+	DomainNO4:               {Name: "NO4", Country: "NO", AreaTypes: bzn},
+	DomainPL:                {Name: "Poland", Country: "PL", AreaTypes: []AreaType{BZN, BZA, CTA, MBA, SCA, IPA, CTY}},
+	DomainPT:                {Name: "Portugal", Country: "PT", AreaTypes: zone},
+	DomainRO:                {Name: "Romania", Country: "RO", AreaTypes: zone},
+	DomainSE:                {Name: "Sweden", Country: "SE", AreaTypes: []AreaType{CTA, MBA, CTY}},
+	DomainSI:                {Name: "Slovenia", Country: "SI", AreaTypes: zone},
+	DomainSK:                {Name: "Slovakia", Country: "SK", AreaTypes: zone},
+	DomainTR:                {Name: "Turkey", Country: "TR", AreaTypes: zone},
+	DomainUABEI:             {Name: "Ukraine BEI", Country: "UA", AreaTypes: []AreaType{BZN, CTA, MBA}},
+	DomainGBElecLink:        {Name: "GB(ElecLink)", Country: "GB", AreaTypes: []AreaType{BZN}},
+	DomainGBIFA2:            {Name: "GB(IFA2)", Country: "GB", AreaTypes: []AreaType{BZN}},
+	DomainDK1NO1:            {Name: "DK1-NO1", AreaTypes: []AreaType{BZN}},
+	DomainNO2NSL:            {Name: "NO2 NSL", Country: "NO", AreaTypes: []AreaType{BZN, MBA}},
+	DomainBY:                {Name: "Belarus", Country: "BY", AreaTypes: []AreaType{BZN, CTA, MBA}},
+	DomainRU:                {Name: "Russia", Country: "RU", AreaTypes: []AreaType{BZN, CTA, MBA}},
+	DomainIS:                {Name: "Iceland", Country: "IS", AreaTypes: []AreaType{BZN, CTY}},
+}
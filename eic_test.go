@@ -0,0 +1,74 @@
+package entsoe
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidEIC(t *testing.T) {
+	for code := range eics {
+		// Belarus, Russia and Iceland only have pseudo-codes
+		if len(code) != 16 {
+			continue
+		}
+		assert.True(t, ValidEIC(code), code)
+	}
+
+	assert.False(t, ValidEIC("10YFR-RTE------D"))
+	assert.False(t, ValidEIC("10YFR-RTE-----C"))
+	assert.False(t, ValidEIC("10yfr-rte------c"))
+}
+
+func TestLookupEIC(t *testing.T) {
+	e, ok := LookupEIC(DomainDELU)
+	if assert.True(t, ok) {
+		assert.Equal(t, DomainDELU, e.Code)
+		assert.Equal(t, "DE", e.Country)
+		assert.True(t, e.HasType(BZN))
+		assert.False(t, e.HasType(CTA))
+		assert.False(t, e.ValidAt(genTime("201809302100")))
+		assert.True(t, e.ValidAt(genTime("201809302200")))
+	}
+
+	e, ok = LookupEIC(DomainDEATLU)
+	if assert.True(t, ok) {
+		assert.True(t, e.ValidAt(genTime("201809302100")))
+		assert.False(t, e.ValidAt(genTime("201809302200")))
+	}
+
+	_, ok = LookupEIC("10YFR-RTE------D")
+	assert.False(t, ok)
+}
+
+func TestDomainRoles(t *testing.T) {
+	requests := 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(testPublicationMarketDocument))
+	}, WithRetryPolicy(NoRetry))
+
+	// a control area is not a bidding zone
+	_, err := c.GetDayAheadPrices(DomainDETenneTGER, genTime("202603012300"), genTime("202603020100"))
+	assert.True(t, errors.Is(err, ErrInvalidParameters))
+
+	// neither is a country
+	_, err = c.GetDayAheadPrices(DomainDE, genTime("202603012300"), genTime("202603020100"))
+	assert.True(t, errors.Is(err, ErrInvalidParameters))
+
+	// bad check character
+	_, err = c.GetDayAheadPrices("10YFR-RTE------D", genTime("202603012300"), genTime("202603020100"))
+	assert.True(t, errors.Is(err, ErrInvalidParameters))
+	assert.Equal(t, 0, requests)
+
+	_, err = c.GetDayAheadPrices(DomainFR, genTime("202603012300"), genTime("202603020100"))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, requests)
+
+	// codes missing from the registry are left to the platform
+	_, err = c.GetDayAheadPrices("10YGO-TEST-----D", genTime("202603012300"), genTime("202603020100"))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, requests)
+}
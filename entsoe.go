@@ -3,6 +3,7 @@
 package entsoe
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
 	"io"
@@ -17,20 +18,28 @@ import (
 
 const (
 	periodLayout = "200601021504"
+
+	DefaultBaseURL = "https://web-api.tp.entsoe.eu/api"
 )
 
 type EntsoeClient struct {
-	apiKey string
+	apiKey     string
+	httpClient *http.Client
+	baseURL    string
+	userAgent  string
+	timeout    time.Duration
+
+	retryPolicy RetryPolicy
+	limiter     *RateLimiter
+
+	maxDecompressedSize int64
 }
 
-func NewEntsoeClient(apiKey string) *EntsoeClient {
-	c := EntsoeClient{
-		apiKey: apiKey,
-	}
-	return &c
+func NewEntsoeClient(apiKey string, opts ...ClientOption) *EntsoeClient {
+	return newEntsoeClient(apiKey, opts)
 }
 
-func NewEntsoeClientFromEnv() *EntsoeClient {
+func NewEntsoeClientFromEnv(opts ...ClientOption) *EntsoeClient {
 
 	if err := godotenv.Load(".env"); err != nil && !os.IsNotExist(err) {
 		logger.Warn().Err(err).Msg("Error loading .env file")
@@ -41,35 +50,43 @@ func NewEntsoeClientFromEnv() *EntsoeClient {
 		logger.Fatal().Msg("Environment variable ENTSOE_API_KEY with api key not set")
 	}
 
+	return newEntsoeClient(apiKey, opts)
+}
+
+func newEntsoeClient(apiKey string, opts []ClientOption) *EntsoeClient {
 	c := EntsoeClient{
-		apiKey: apiKey,
+		apiKey:     apiKey,
+		httpClient: http.DefaultClient,
+		baseURL:    DefaultBaseURL,
+
+		retryPolicy: DefaultRetryPolicy,
+		limiter:     NewRateLimiter(DefaultRateLimit, time.Minute, DefaultRateBurst),
+
+		maxDecompressedSize: DefaultMaxDecompressedSize,
+	}
+	for _, opt := range opts {
+		opt(&c)
 	}
-	return &c
-}
 
-// Helper functions
+	// never mutate a caller supplied client, work on a shallow copy instead
+	if c.timeout > 0 {
+		hc := *c.httpClient
+		hc.Timeout = c.timeout
+		c.httpClient = &hc
+	}
 
-var durations = map[ResolutionType]time.Duration{
-	ResolutionQuarter:  15 * time.Minute,
-	ResolutionHalfHour: 30 * time.Minute,
-	ResolutionHour:     time.Hour,
+	return &c
 }
 
-func GetPointTime(start time.Time, position int, resolution ResolutionType) time.Time {
-	offset := position - 1
+// Helper functions
 
-	switch resolution {
-	case ResolutionQuarter, ResolutionHalfHour, ResolutionHour:
-		return start.Add(time.Duration(offset) * durations[resolution])
-	case ResolutionDay:
-		return start.AddDate(0, 0, offset)
-	case ResolutionWeek:
-		return start.AddDate(0, 0, 7*offset)
-	case ResolutionYear:
-		return start.AddDate(offset, 0, 0)
+// GetPointTime returns the start of the point at position (starting at 1)
+// of a period starting at start.
+func GetPointTime(start time.Time, position int, resolution ResolutionType) (time.Time, error) {
+	if position < 1 {
+		return time.Time{}, fmt.Errorf("invalid point position %d", position)
 	}
-
-	return time.Time{}
+	return resolution.Step(start, position-1)
 }
 
 // 4.1. Load domain
@@ -79,6 +96,16 @@ func (c *EntsoeClient) GetActualTotalLoad(
 	domain DomainType,
 	periodStart time.Time,
 	periodEnd time.Time,
+) (*GLMarketDocument, error) {
+	return c.GetActualTotalLoadContext(context.Background(), domain, periodStart, periodEnd)
+}
+
+// GetActualTotalLoadContext is like GetActualTotalLoad but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetActualTotalLoadContext(
+	ctx context.Context,
+	domain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
 ) (*GLMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeSystemTotalLoad))
@@ -86,7 +113,7 @@ func (c *EntsoeClient) GetActualTotalLoad(
 	params.Add(ParameterOutBiddingZoneDomain, string(domain))
 	params.Add(ParameterPeriodStart, periodStart.UTC().Format(periodLayout))
 	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format(periodLayout))
-	return c.requestGLMarketDocument(params)
+	return c.requestGLMarketDocument(ctx, params)
 }
 
 // 4.1.2. Day-Ahead Total Load Forecast [6.1.B]
@@ -94,6 +121,16 @@ func (c *EntsoeClient) GetDayAheadTotalLoadForecast(
 	domain DomainType,
 	periodStart time.Time,
 	periodEnd time.Time,
+) (*GLMarketDocument, error) {
+	return c.GetDayAheadTotalLoadForecastContext(context.Background(), domain, periodStart, periodEnd)
+}
+
+// GetDayAheadTotalLoadForecastContext is like GetDayAheadTotalLoadForecast but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetDayAheadTotalLoadForecastContext(
+	ctx context.Context,
+	domain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
 ) (*GLMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeSystemTotalLoad))
@@ -101,7 +138,7 @@ func (c *EntsoeClient) GetDayAheadTotalLoadForecast(
 	params.Add(ParameterOutBiddingZoneDomain, string(domain))
 	params.Add(ParameterPeriodStart, periodStart.UTC().Format(periodLayout))
 	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format(periodLayout))
-	return c.requestGLMarketDocument(params)
+	return c.requestGLMarketDocument(ctx, params)
 }
 
 // 4.1.3. Week-Ahead Total Load Forecast [6.1.C]
@@ -109,6 +146,16 @@ func (c *EntsoeClient) GetWeekAheadTotalLoadForecast(
 	domain DomainType,
 	periodStart time.Time,
 	periodEnd time.Time,
+) (*GLMarketDocument, error) {
+	return c.GetWeekAheadTotalLoadForecastContext(context.Background(), domain, periodStart, periodEnd)
+}
+
+// GetWeekAheadTotalLoadForecastContext is like GetWeekAheadTotalLoadForecast but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetWeekAheadTotalLoadForecastContext(
+	ctx context.Context,
+	domain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
 ) (*GLMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeSystemTotalLoad))
@@ -116,7 +163,7 @@ func (c *EntsoeClient) GetWeekAheadTotalLoadForecast(
 	params.Add(ParameterOutBiddingZoneDomain, string(domain))
 	params.Add(ParameterPeriodStart, periodStart.UTC().Format(periodLayout))
 	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format(periodLayout))
-	return c.requestGLMarketDocument(params)
+	return c.requestGLMarketDocument(ctx, params)
 }
 
 // 4.1.4. Month-Ahead Total Load Forecast [6.1.D]
@@ -124,6 +171,16 @@ func (c *EntsoeClient) GetMonthAheadTotalLoadForecast(
 	domain DomainType,
 	periodStart time.Time,
 	periodEnd time.Time,
+) (*GLMarketDocument, error) {
+	return c.GetMonthAheadTotalLoadForecastContext(context.Background(), domain, periodStart, periodEnd)
+}
+
+// GetMonthAheadTotalLoadForecastContext is like GetMonthAheadTotalLoadForecast but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetMonthAheadTotalLoadForecastContext(
+	ctx context.Context,
+	domain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
 ) (*GLMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeSystemTotalLoad))
@@ -131,7 +188,7 @@ func (c *EntsoeClient) GetMonthAheadTotalLoadForecast(
 	params.Add(ParameterOutBiddingZoneDomain, string(domain))
 	params.Add(ParameterPeriodStart, periodStart.UTC().Format(periodLayout))
 	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format(periodLayout))
-	return c.requestGLMarketDocument(params)
+	return c.requestGLMarketDocument(ctx, params)
 }
 
 // 4.1.5. Year-Ahead Total Load Forecast [6.1.E]
@@ -139,6 +196,16 @@ func (c *EntsoeClient) GetYearAheadTotalLoadForecast(
 	domain DomainType,
 	periodStart time.Time,
 	periodEnd time.Time,
+) (*GLMarketDocument, error) {
+	return c.GetYearAheadTotalLoadForecastContext(context.Background(), domain, periodStart, periodEnd)
+}
+
+// GetYearAheadTotalLoadForecastContext is like GetYearAheadTotalLoadForecast but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetYearAheadTotalLoadForecastContext(
+	ctx context.Context,
+	domain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
 ) (*GLMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeSystemTotalLoad))
@@ -146,7 +213,7 @@ func (c *EntsoeClient) GetYearAheadTotalLoadForecast(
 	params.Add(ParameterOutBiddingZoneDomain, string(domain))
 	params.Add(ParameterPeriodStart, periodStart.UTC().Format(periodLayout))
 	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format(periodLayout))
-	return c.requestGLMarketDocument(params)
+	return c.requestGLMarketDocument(ctx, params)
 }
 
 // 4.1.6. Year-Ahead Forecast Margin [8.1]
@@ -154,6 +221,16 @@ func (c *EntsoeClient) GetYearAheadForecastMargin(
 	domain DomainType,
 	periodStart time.Time,
 	periodEnd time.Time,
+) (*GLMarketDocument, error) {
+	return c.GetYearAheadForecastMarginContext(context.Background(), domain, periodStart, periodEnd)
+}
+
+// GetYearAheadForecastMarginContext is like GetYearAheadForecastMargin but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetYearAheadForecastMarginContext(
+	ctx context.Context,
+	domain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
 ) (*GLMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeLoadForecastMargin))
@@ -161,7 +238,7 @@ func (c *EntsoeClient) GetYearAheadForecastMargin(
 	params.Add(ParameterOutBiddingZoneDomain, string(domain))
 	params.Add(ParameterPeriodStart, periodStart.UTC().Format(periodLayout))
 	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format(periodLayout))
-	return c.requestGLMarketDocument(params)
+	return c.requestGLMarketDocument(ctx, params)
 }
 
 // 4.2. Transmission domain
@@ -174,6 +251,19 @@ func (c *EntsoeClient) GetExpansionAndDismantlingProjects(
 	periodEnd time.Time,
 	business *BusinessType,
 	docStatus *DocStatus,
+) (*TransmissionNetworkMarketDocument, error) {
+	return c.GetExpansionAndDismantlingProjectsContext(context.Background(), inDomain, outDomain, periodStart, periodEnd, business, docStatus)
+}
+
+// GetExpansionAndDismantlingProjectsContext is like GetExpansionAndDismantlingProjects but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetExpansionAndDismantlingProjectsContext(
+	ctx context.Context,
+	inDomain DomainType,
+	outDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	business *BusinessType,
+	docStatus *DocStatus,
 ) (*TransmissionNetworkMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeInterconnectionNetworkExpansion))
@@ -187,7 +277,7 @@ func (c *EntsoeClient) GetExpansionAndDismantlingProjects(
 	if docStatus != nil {
 		params.Add(ParameterDocStatus, string(*docStatus))
 	}
-	return c.requestTransmissionNetworkMarketDocument(params)
+	return c.requestTransmissionNetworkMarketDocument(ctx, params)
 }
 
 // 4.2.2. Forecasted Capacity [11.1.A]
@@ -197,6 +287,18 @@ func (c *EntsoeClient) GetForecastedCapacity(
 	outDomain DomainType,
 	periodStart time.Time,
 	periodEnd time.Time,
+) (*PublicationMarketDocument, error) {
+	return c.GetForecastedCapacityContext(context.Background(), contractMarketAgreement, inDomain, outDomain, periodStart, periodEnd)
+}
+
+// GetForecastedCapacityContext is like GetForecastedCapacity but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetForecastedCapacityContext(
+	ctx context.Context,
+	contractMarketAgreement ContractMarketAgreementType,
+	inDomain DomainType,
+	outDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
 ) (*PublicationMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeEstimatedNetTransferCapacity))
@@ -205,7 +307,7 @@ func (c *EntsoeClient) GetForecastedCapacity(
 	params.Add(ParameterOutDomain, string(outDomain))
 	params.Add(ParameterPeriodStart, periodStart.UTC().Format(periodLayout))
 	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format(periodLayout))
-	return c.requestPublicationMarketDocument(params)
+	return c.requestPublicationMarketDocument(ctx, params)
 }
 
 // 4.2.3. Offered Capacity [11.1.A]
@@ -218,6 +320,21 @@ func (c *EntsoeClient) GetOfferedCapacity(
 	periodEnd time.Time,
 	auctionCategory *AuctionCategory,
 	classificationSequenceAttributeInstanceComponentPosition *int,
+) (*PublicationMarketDocument, error) {
+	return c.GetOfferedCapacityContext(context.Background(), auctionType, contractMarketAgreement, inDomain, outDomain, periodStart, periodEnd, auctionCategory, classificationSequenceAttributeInstanceComponentPosition)
+}
+
+// GetOfferedCapacityContext is like GetOfferedCapacity but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetOfferedCapacityContext(
+	ctx context.Context,
+	auctionType AuctionType,
+	contractMarketAgreement ContractMarketAgreementType,
+	inDomain DomainType,
+	outDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	auctionCategory *AuctionCategory,
+	classificationSequenceAttributeInstanceComponentPosition *int,
 ) (*PublicationMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeAgreedCapacity))
@@ -233,7 +350,7 @@ func (c *EntsoeClient) GetOfferedCapacity(
 	if auctionCategory != nil {
 		params.Add(ParameterClassificationSequenceAttributeInstanceComponentPosition, strconv.Itoa(*classificationSequenceAttributeInstanceComponentPosition))
 	}
-	return c.requestPublicationMarketDocument(params)
+	return c.requestPublicationMarketDocument(ctx, params)
 }
 
 // 4.2.4. Flow-based Parameters [11.1.B]
@@ -242,6 +359,17 @@ func (c *EntsoeClient) GetFlowBasedParameters(
 	domain DomainType,
 	periodStart time.Time,
 	periodEnd time.Time,
+) (*CriticalNetworkElementMarketDocument, error) {
+	return c.GetFlowBasedParametersContext(context.Background(), processType, domain, periodStart, periodEnd)
+}
+
+// GetFlowBasedParametersContext is like GetFlowBasedParameters but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetFlowBasedParametersContext(
+	ctx context.Context,
+	processType ProcessType,
+	domain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
 ) (*CriticalNetworkElementMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeFlowBasedAllocations))
@@ -250,7 +378,7 @@ func (c *EntsoeClient) GetFlowBasedParameters(
 	params.Add(ParameterOutDomain, string(domain))
 	params.Add(ParameterPeriodStart, periodStart.UTC().Format(periodLayout))
 	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format(periodLayout))
-	return c.requestCriticalNetworkElementMarketDocument(params)
+	return c.requestCriticalNetworkElementMarketDocument(ctx, params)
 }
 
 // 4.2.5. Intraday Transfer Limits [11.3]
@@ -259,6 +387,17 @@ func (c *EntsoeClient) GetIntradayTransferLimits(
 	outDomain DomainType,
 	periodStart time.Time,
 	periodEnd time.Time,
+) (*PublicationMarketDocument, error) {
+	return c.GetIntradayTransferLimitsContext(context.Background(), inDomain, outDomain, periodStart, periodEnd)
+}
+
+// GetIntradayTransferLimitsContext is like GetIntradayTransferLimits but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetIntradayTransferLimitsContext(
+	ctx context.Context,
+	inDomain DomainType,
+	outDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
 ) (*PublicationMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeDcLinkCapacity))
@@ -266,7 +405,7 @@ func (c *EntsoeClient) GetIntradayTransferLimits(
 	params.Add(ParameterOutDomain, string(outDomain))
 	params.Add(ParameterPeriodStart, periodStart.UTC().Format(periodLayout))
 	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format(periodLayout))
-	return c.requestPublicationMarketDocument(params)
+	return c.requestPublicationMarketDocument(ctx, params)
 }
 
 // 4.2.6. Explicit Allocation Information (Capacity) [12.1.A]
@@ -280,6 +419,21 @@ func (c *EntsoeClient) GetExplicitAllocationInformation(
 	periodEnd time.Time,
 	auctionCategory *AuctionCategory,
 	classificationSequenceAttributeInstanceComponentPosition *int,
+) (*PublicationMarketDocument, error) {
+	return c.GetExplicitAllocationInformationContext(context.Background(), businessType, contractMarketAgreementType, inDomain, outDomain, periodStart, periodEnd, auctionCategory, classificationSequenceAttributeInstanceComponentPosition)
+}
+
+// GetExplicitAllocationInformationContext is like GetExplicitAllocationInformation but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetExplicitAllocationInformationContext(
+	ctx context.Context,
+	businessType BusinessType,
+	contractMarketAgreementType ContractMarketAgreementType,
+	inDomain DomainType,
+	outDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	auctionCategory *AuctionCategory,
+	classificationSequenceAttributeInstanceComponentPosition *int,
 ) (*PublicationMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeAllocationResultDocument))
@@ -295,7 +449,7 @@ func (c *EntsoeClient) GetExplicitAllocationInformation(
 	if classificationSequenceAttributeInstanceComponentPosition != nil {
 		params.Add(ParameterClassificationSequenceAttributeInstanceComponentPosition, strconv.Itoa(*classificationSequenceAttributeInstanceComponentPosition))
 	}
-	return c.requestPublicationMarketDocument(params)
+	return c.requestPublicationMarketDocument(ctx, params)
 }
 
 // 4.2.8. Total Capacity Nominated [12.1.B]
@@ -305,6 +459,18 @@ func (c *EntsoeClient) GetTotalCapacityNominated(
 	outDomain DomainType,
 	periodStart time.Time,
 	periodEnd time.Time,
+) (*PublicationMarketDocument, error) {
+	return c.GetTotalCapacityNominatedContext(context.Background(), businessType, inDomain, outDomain, periodStart, periodEnd)
+}
+
+// GetTotalCapacityNominatedContext is like GetTotalCapacityNominated but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetTotalCapacityNominatedContext(
+	ctx context.Context,
+	businessType BusinessType,
+	inDomain DomainType,
+	outDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
 ) (*PublicationMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeCapacityDocument))
@@ -313,7 +479,7 @@ func (c *EntsoeClient) GetTotalCapacityNominated(
 	params.Add(ParameterOutDomain, string(outDomain))
 	params.Add(ParameterPeriodStart, periodStart.UTC().Format(periodLayout))
 	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format(periodLayout))
-	return c.requestPublicationMarketDocument(params)
+	return c.requestPublicationMarketDocument(ctx, params)
 }
 
 // 4.2.9. Total Capacity Already Allocated [12.1.C]
@@ -325,6 +491,20 @@ func (c *EntsoeClient) GetTotalCapacityAlreadyAllocated(
 	periodStart time.Time,
 	periodEnd time.Time,
 	auctionCategory *AuctionCategory,
+) (*PublicationMarketDocument, error) {
+	return c.GetTotalCapacityAlreadyAllocatedContext(context.Background(), businessType, contractMarketAgreementType, inDomain, outDomain, periodStart, periodEnd, auctionCategory)
+}
+
+// GetTotalCapacityAlreadyAllocatedContext is like GetTotalCapacityAlreadyAllocated but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetTotalCapacityAlreadyAllocatedContext(
+	ctx context.Context,
+	businessType BusinessType,
+	contractMarketAgreementType ContractMarketAgreementType,
+	inDomain DomainType,
+	outDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	auctionCategory *AuctionCategory,
 ) (*PublicationMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeCapacityDocument))
@@ -336,7 +516,7 @@ func (c *EntsoeClient) GetTotalCapacityAlreadyAllocated(
 	if auctionCategory != nil {
 		params.Add(ParameterAuctionCategory, string(*auctionCategory))
 	}
-	return c.requestPublicationMarketDocument(params)
+	return c.requestPublicationMarketDocument(ctx, params)
 }
 
 // 4.2.10. Day Ahead Prices [12.1.D]
@@ -346,6 +526,16 @@ func (c *EntsoeClient) GetDayAheadPrices(
 	domain DomainType,
 	periodStart time.Time,
 	periodEnd time.Time,
+) (*PublicationMarketDocument, error) {
+	return c.GetDayAheadPricesContext(context.Background(), domain, periodStart, periodEnd)
+}
+
+// GetDayAheadPricesContext is like GetDayAheadPrices but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetDayAheadPricesContext(
+	ctx context.Context,
+	domain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
 ) (*PublicationMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypePriceDocument))
@@ -354,7 +544,7 @@ func (c *EntsoeClient) GetDayAheadPrices(
 	params.Add(ParameterOutDomain, string(domain))
 	params.Add(ParameterPeriodStart, periodStart.UTC().Format(periodLayout))
 	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format(periodLayout))
-	return c.requestPublicationMarketDocument(params)
+	return c.requestPublicationMarketDocument(ctx, params)
 }
 
 // 4.2.11. Implicit Auction — Net Positions [12.1.E]
@@ -365,6 +555,18 @@ func (c *EntsoeClient) GetImplicitAuction(
 	domain DomainType,
 	periodStart time.Time,
 	periodEnd time.Time,
+) (*PublicationMarketDocument, error) {
+	return c.GetImplicitAuctionContext(context.Background(), businessType, contractMarketAgreementType, domain, periodStart, periodEnd)
+}
+
+// GetImplicitAuctionContext is like GetImplicitAuction but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetImplicitAuctionContext(
+	ctx context.Context,
+	businessType BusinessType,
+	contractMarketAgreementType ContractMarketAgreementType,
+	domain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
 ) (*PublicationMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeAllocationResultDocument))
@@ -374,7 +576,7 @@ func (c *EntsoeClient) GetImplicitAuction(
 	params.Add(ParameterOutDomain, string(domain))
 	params.Add(ParameterPeriodStart, periodStart.UTC().Format(periodLayout))
 	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format(periodLayout))
-	return c.requestPublicationMarketDocument(params)
+	return c.requestPublicationMarketDocument(ctx, params)
 }
 
 // 4.2.13. Total Commercial Schedules [12.1.F]
@@ -384,6 +586,18 @@ func (c *EntsoeClient) GetTotalCommercialSchedules(
 	periodStart time.Time,
 	periodEnd time.Time,
 	contractType *ContractMarketAgreementType,
+) (*PublicationMarketDocument, error) {
+	return c.GetTotalCommercialSchedulesContext(context.Background(), inDomain, outDomain, periodStart, periodEnd, contractType)
+}
+
+// GetTotalCommercialSchedulesContext is like GetTotalCommercialSchedules but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetTotalCommercialSchedulesContext(
+	ctx context.Context,
+	inDomain DomainType,
+	outDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	contractType *ContractMarketAgreementType,
 ) (*PublicationMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeFinalisedSchedule))
@@ -394,7 +608,7 @@ func (c *EntsoeClient) GetTotalCommercialSchedules(
 	if contractType != nil {
 		params.Add(ParameterContractMarketAgreementType, string(*contractType))
 	}
-	return c.requestPublicationMarketDocument(params)
+	return c.requestPublicationMarketDocument(ctx, params)
 }
 
 // 4.2.14. Day-ahead Commercial Schedules [12.1.F]
@@ -404,6 +618,18 @@ func (c *EntsoeClient) GetDayAheadCommercialSchedules(
 	periodStart time.Time,
 	periodEnd time.Time,
 	contractType *ContractMarketAgreementType,
+) (*PublicationMarketDocument, error) {
+	return c.GetDayAheadCommercialSchedulesContext(context.Background(), inDomain, outDomain, periodStart, periodEnd, contractType)
+}
+
+// GetDayAheadCommercialSchedulesContext is like GetDayAheadCommercialSchedules but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetDayAheadCommercialSchedulesContext(
+	ctx context.Context,
+	inDomain DomainType,
+	outDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	contractType *ContractMarketAgreementType,
 ) (*PublicationMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeFinalisedSchedule))
@@ -414,7 +640,7 @@ func (c *EntsoeClient) GetDayAheadCommercialSchedules(
 	if contractType != nil {
 		params.Add(ParameterContractMarketAgreementType, string(*contractType))
 	}
-	return c.requestPublicationMarketDocument(params)
+	return c.requestPublicationMarketDocument(ctx, params)
 }
 
 // 4.2.15. Physical Flows [12.1.G]
@@ -423,6 +649,17 @@ func (c *EntsoeClient) GetPhysicalFlows(
 	outDomain DomainType,
 	periodStart time.Time,
 	periodEnd time.Time,
+) (*PublicationMarketDocument, error) {
+	return c.GetPhysicalFlowsContext(context.Background(), inDomain, outDomain, periodStart, periodEnd)
+}
+
+// GetPhysicalFlowsContext is like GetPhysicalFlows but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetPhysicalFlowsContext(
+	ctx context.Context,
+	inDomain DomainType,
+	outDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
 ) (*PublicationMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeAggregatedEnergyDataReport))
@@ -430,7 +667,7 @@ func (c *EntsoeClient) GetPhysicalFlows(
 	params.Add(ParameterOutDomain, string(outDomain))
 	params.Add(ParameterPeriodStart, periodStart.UTC().Format(periodLayout))
 	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format(periodLayout))
-	return c.requestPublicationMarketDocument(params)
+	return c.requestPublicationMarketDocument(ctx, params)
 }
 
 // 4.2.16. Capacity Allocated Outside EU [12.1.H]
@@ -443,6 +680,21 @@ func (c *EntsoeClient) GetCapacityAllocatedOutsideEu(
 	periodEnd time.Time,
 	auctionCategory *AuctionCategory,
 	classificationSequenceAttributeInstanceComponentPosition *int,
+) (*PublicationMarketDocument, error) {
+	return c.GetCapacityAllocatedOutsideEuContext(context.Background(), auctionType, contractMarketAgreementType, inDomain, outDomain, periodStart, periodEnd, auctionCategory, classificationSequenceAttributeInstanceComponentPosition)
+}
+
+// GetCapacityAllocatedOutsideEuContext is like GetCapacityAllocatedOutsideEu but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetCapacityAllocatedOutsideEuContext(
+	ctx context.Context,
+	auctionType AuctionType,
+	contractMarketAgreementType ContractMarketAgreementType,
+	inDomain DomainType,
+	outDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	auctionCategory *AuctionCategory,
+	classificationSequenceAttributeInstanceComponentPosition *int,
 ) (*PublicationMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeNonEuAllocations))
@@ -458,7 +710,7 @@ func (c *EntsoeClient) GetCapacityAllocatedOutsideEu(
 	if classificationSequenceAttributeInstanceComponentPosition != nil {
 		params.Add(ParameterClassificationSequenceAttributeInstanceComponentPosition, strconv.Itoa(*classificationSequenceAttributeInstanceComponentPosition))
 	}
-	return c.requestPublicationMarketDocument(params)
+	return c.requestPublicationMarketDocument(ctx, params)
 }
 
 // 4.3. Congestion domain
@@ -470,6 +722,18 @@ func (c *EntsoeClient) GetRedispatching(
 	periodStart time.Time,
 	periodEnd time.Time,
 	business *BusinessType,
+) (*TransmissionNetworkMarketDocument, error) {
+	return c.GetRedispatchingContext(context.Background(), inDomain, outDomain, periodStart, periodEnd, business)
+}
+
+// GetRedispatchingContext is like GetRedispatching but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetRedispatchingContext(
+	ctx context.Context,
+	inDomain DomainType,
+	outDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	business *BusinessType,
 ) (*TransmissionNetworkMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeRedispatchNotice))
@@ -480,7 +744,7 @@ func (c *EntsoeClient) GetRedispatching(
 	if business != nil {
 		params.Add(ParameterBusinessType, string(*business))
 	}
-	return c.requestTransmissionNetworkMarketDocument(params)
+	return c.requestTransmissionNetworkMarketDocument(ctx, params)
 }
 
 // 4.3.2. Countertrading [13.1.B]
@@ -489,6 +753,17 @@ func (c *EntsoeClient) GetCountertrading(
 	outDomain DomainType,
 	periodStart time.Time,
 	periodEnd time.Time,
+) (*TransmissionNetworkMarketDocument, error) {
+	return c.GetCountertradingContext(context.Background(), inDomain, outDomain, periodStart, periodEnd)
+}
+
+// GetCountertradingContext is like GetCountertrading but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetCountertradingContext(
+	ctx context.Context,
+	inDomain DomainType,
+	outDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
 ) (*TransmissionNetworkMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeCounterTradeNotice))
@@ -496,7 +771,7 @@ func (c *EntsoeClient) GetCountertrading(
 	params.Add(ParameterOutDomain, string(outDomain))
 	params.Add(ParameterPeriodStart, periodStart.UTC().Format(periodLayout))
 	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format(periodLayout))
-	return c.requestTransmissionNetworkMarketDocument(params)
+	return c.requestTransmissionNetworkMarketDocument(ctx, params)
 }
 
 // 4.3.3. Costs of Congestion Management [13.1.C]
@@ -505,6 +780,17 @@ func (c *EntsoeClient) GetCostsOfCongestionManagement(
 	periodStart time.Time,
 	periodEnd time.Time,
 	business *BusinessType,
+) (*TransmissionNetworkMarketDocument, error) {
+	return c.GetCostsOfCongestionManagementContext(context.Background(), domain, periodStart, periodEnd, business)
+}
+
+// GetCostsOfCongestionManagementContext is like GetCostsOfCongestionManagement but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetCostsOfCongestionManagementContext(
+	ctx context.Context,
+	domain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	business *BusinessType,
 ) (*TransmissionNetworkMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeCongestionCosts))
@@ -515,7 +801,7 @@ func (c *EntsoeClient) GetCostsOfCongestionManagement(
 	if business != nil {
 		params.Add(ParameterBusinessType, string(*business))
 	}
-	return c.requestTransmissionNetworkMarketDocument(params)
+	return c.requestTransmissionNetworkMarketDocument(ctx, params)
 }
 
 // 4.4. Generation domain
@@ -527,6 +813,18 @@ func (c *EntsoeClient) GetInstalledGenerationCapacityAggregated(
 	periodStart time.Time,
 	periodEnd time.Time,
 	psrType *PsrType,
+) (*GLMarketDocument, error) {
+	return c.GetInstalledGenerationCapacityAggregatedContext(context.Background(), processType, inDomain, periodStart, periodEnd, psrType)
+}
+
+// GetInstalledGenerationCapacityAggregatedContext is like GetInstalledGenerationCapacityAggregated but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetInstalledGenerationCapacityAggregatedContext(
+	ctx context.Context,
+	processType ProcessType,
+	inDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	psrType *PsrType,
 ) (*GLMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeInstalledGenerationPerType))
@@ -537,7 +835,7 @@ func (c *EntsoeClient) GetInstalledGenerationCapacityAggregated(
 	if psrType != nil {
 		params.Add(ParameterPsrType, string(*psrType))
 	}
-	return c.requestGLMarketDocument(params)
+	return c.requestGLMarketDocument(ctx, params)
 }
 
 // 4.4.2. Installed Generation Capacity per Unit [14.1.B]
@@ -547,6 +845,18 @@ func (c *EntsoeClient) GetInstalledGenerationCapacityPerUnit(
 	periodStart time.Time,
 	periodEnd time.Time,
 	psrType *PsrType,
+) (*GLMarketDocument, error) {
+	return c.GetInstalledGenerationCapacityPerUnitContext(context.Background(), processType, inDomain, periodStart, periodEnd, psrType)
+}
+
+// GetInstalledGenerationCapacityPerUnitContext is like GetInstalledGenerationCapacityPerUnit but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetInstalledGenerationCapacityPerUnitContext(
+	ctx context.Context,
+	processType ProcessType,
+	inDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	psrType *PsrType,
 ) (*GLMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeGenerationForecast))
@@ -557,7 +867,7 @@ func (c *EntsoeClient) GetInstalledGenerationCapacityPerUnit(
 	if psrType != nil {
 		params.Add(ParameterPsrType, string(*psrType))
 	}
-	return c.requestGLMarketDocument(params)
+	return c.requestGLMarketDocument(ctx, params)
 }
 
 // 4.4.3. Day-ahead Aggregated Generation [14.1.C]
@@ -566,6 +876,17 @@ func (c *EntsoeClient) GetDayAheadAggregatedGeneration(
 	inDomain DomainType,
 	periodStart time.Time,
 	periodEnd time.Time,
+) (*GLMarketDocument, error) {
+	return c.GetDayAheadAggregatedGenerationContext(context.Background(), processType, inDomain, periodStart, periodEnd)
+}
+
+// GetDayAheadAggregatedGenerationContext is like GetDayAheadAggregatedGeneration but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetDayAheadAggregatedGenerationContext(
+	ctx context.Context,
+	processType ProcessType,
+	inDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
 ) (*GLMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeGenerationForecast))
@@ -573,7 +894,7 @@ func (c *EntsoeClient) GetDayAheadAggregatedGeneration(
 	params.Add(ParameterInDomain, string(inDomain))
 	params.Add(ParameterPeriodStart, periodStart.UTC().Format(periodLayout))
 	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format(periodLayout))
-	return c.requestGLMarketDocument(params)
+	return c.requestGLMarketDocument(ctx, params)
 }
 
 // 4.4.4. Day-ahead Generation Forecasts for Wind and Solar [14.1.D]
@@ -585,6 +906,18 @@ func (c *EntsoeClient) GetGenerationForecastsForWindAndSolar(
 	periodStart time.Time,
 	periodEnd time.Time,
 	psrType *PsrType,
+) (*GLMarketDocument, error) {
+	return c.GetGenerationForecastsForWindAndSolarContext(context.Background(), processType, inDomain, periodStart, periodEnd, psrType)
+}
+
+// GetGenerationForecastsForWindAndSolarContext is like GetGenerationForecastsForWindAndSolar but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetGenerationForecastsForWindAndSolarContext(
+	ctx context.Context,
+	processType ProcessType,
+	inDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	psrType *PsrType,
 ) (*GLMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeWindAndSolarForecast))
@@ -595,7 +928,7 @@ func (c *EntsoeClient) GetGenerationForecastsForWindAndSolar(
 	if psrType != nil {
 		params.Add(ParameterPsrType, string(*psrType))
 	}
-	return c.requestGLMarketDocument(params)
+	return c.requestGLMarketDocument(ctx, params)
 }
 
 // 4.4.7. Actual Generation Output per Generation Unit [16.1.A]
@@ -606,6 +939,19 @@ func (c *EntsoeClient) GetActualGenerationOutputPerGenerationUnit(
 	periodEnd time.Time,
 	psrType *PsrType,
 	registeredResource *string,
+) (*GLMarketDocument, error) {
+	return c.GetActualGenerationOutputPerGenerationUnitContext(context.Background(), processType, inDomain, periodStart, periodEnd, psrType, registeredResource)
+}
+
+// GetActualGenerationOutputPerGenerationUnitContext is like GetActualGenerationOutputPerGenerationUnit but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetActualGenerationOutputPerGenerationUnitContext(
+	ctx context.Context,
+	processType ProcessType,
+	inDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	psrType *PsrType,
+	registeredResource *string,
 ) (*GLMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeActualGeneration))
@@ -619,25 +965,40 @@ func (c *EntsoeClient) GetActualGenerationOutputPerGenerationUnit(
 	if registeredResource != nil {
 		params.Add(ParameterRegisteredResource, *registeredResource)
 	}
-	return c.requestGLMarketDocument(params)
+	return c.requestGLMarketDocument(ctx, params)
 }
 
 // 4.4.8. Aggregated Generation per Type [16.1.B&C]
+// An empty psrType fetches every production type in one request.
 func (c *EntsoeClient) GetAggregatedGenerationPerType(
 	processType ProcessType,
 	psrType PsrType,
 	inDomain DomainType,
 	periodStart time.Time,
 	periodEnd time.Time,
+) (*GLMarketDocument, error) {
+	return c.GetAggregatedGenerationPerTypeContext(context.Background(), processType, psrType, inDomain, periodStart, periodEnd)
+}
+
+// GetAggregatedGenerationPerTypeContext is like GetAggregatedGenerationPerType but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetAggregatedGenerationPerTypeContext(
+	ctx context.Context,
+	processType ProcessType,
+	psrType PsrType,
+	inDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
 ) (*GLMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeActualGenerationPerType))
 	params.Add(ParameterProcessType, string(processType))
-	params.Add(ParameterPsrType, string(psrType))
+	if psrType != "" {
+		params.Add(ParameterPsrType, string(psrType))
+	}
 	params.Add(ParameterInDomain, string(inDomain))
 	params.Add(ParameterPeriodStart, periodStart.UTC().Format(periodLayout))
 	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format(periodLayout))
-	return c.requestGLMarketDocument(params)
+	return c.requestGLMarketDocument(ctx, params)
 }
 
 // 4.4.9. Aggregated Filling Rate of Water Reservoirs and Hydro Storage Plants [16.1.D]
@@ -646,6 +1007,17 @@ func (c *EntsoeClient) GetAggregatedFillingRateOfWaterReservoirsAndHydroStorageP
 	inDomain DomainType,
 	periodStart time.Time,
 	periodEnd time.Time,
+) (*GLMarketDocument, error) {
+	return c.GetAggregatedFillingRateOfWaterReservoirsAndHydroStoragePlantsContext(context.Background(), processType, inDomain, periodStart, periodEnd)
+}
+
+// GetAggregatedFillingRateOfWaterReservoirsAndHydroStoragePlantsContext is like GetAggregatedFillingRateOfWaterReservoirsAndHydroStoragePlants but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetAggregatedFillingRateOfWaterReservoirsAndHydroStoragePlantsContext(
+	ctx context.Context,
+	processType ProcessType,
+	inDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
 ) (*GLMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeReservoirFillingInformation))
@@ -653,74 +1025,971 @@ func (c *EntsoeClient) GetAggregatedFillingRateOfWaterReservoirsAndHydroStorageP
 	params.Add(ParameterInDomain, string(inDomain))
 	params.Add(ParameterPeriodStart, periodStart.UTC().Format(periodLayout))
 	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format(periodLayout))
-	return c.requestGLMarketDocument(params)
+	return c.requestGLMarketDocument(ctx, params)
 }
 
-func (c *EntsoeClient) requestGLMarketDocument(params url.Values) (*GLMarketDocument, error) {
-	paramStr := params.Encode()
-	data, err := c.sendRequest(paramStr)
-	if err != nil {
-		return nil, err
-	}
-	var doc GLMarketDocument
-	err = xml.Unmarshal(data, &doc)
-	if err != nil {
-		return nil, generateParsingError(data)
+// 4.5. Master Data
+
+// 4.5.1. Production and Generation Units
+// implementationDate is a calendar date, sent as given in its location.
+func (c *EntsoeClient) GetProductionAndGenerationUnits(
+	domain DomainType,
+	implementationDate time.Time,
+	psrType *PsrType,
+) (*ConfigurationMarketDocument, error) {
+	return c.GetProductionAndGenerationUnitsContext(context.Background(), domain, implementationDate, psrType)
+}
+
+// GetProductionAndGenerationUnitsContext is like GetProductionAndGenerationUnits but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetProductionAndGenerationUnitsContext(
+	ctx context.Context,
+	domain DomainType,
+	implementationDate time.Time,
+	psrType *PsrType,
+) (*ConfigurationMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeConfigurationDocument))
+	params.Add(ParameterBusinessType, string(BusinessTypeProductionUnit))
+	params.Add(ParameterBiddingZoneDomain, string(domain))
+	params.Add(ParameterImplementationDateAndOrTime, implementationDate.Format("2006-01-02"))
+	if psrType != nil {
+		params.Add(ParameterPsrType, string(*psrType))
 	}
-	return &doc, nil
+	return c.requestConfigurationMarketDocument(ctx, params)
 }
 
-func (c *EntsoeClient) requestTransmissionNetworkMarketDocument(params url.Values) (*TransmissionNetworkMarketDocument, error) {
-	paramStr := params.Encode()
-	data, err := c.sendRequest(paramStr)
+// 4.6. Balancing domain
+
+// 4.6.1. Current Balancing State [GL EB 12.3.A]
+func (c *EntsoeClient) GetCurrentBalancingState(
+	area DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	return c.GetCurrentBalancingStateContext(context.Background(), area, periodStart, periodEnd)
+}
+
+// GetCurrentBalancingStateContext is like GetCurrentBalancingState but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetCurrentBalancingStateContext(
+	ctx context.Context,
+	area DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeImbalanceVolume))
+	params.Add(ParameterBusinessType, string(BusinessTypeAreaControlError))
+	params.Add(ParameterAreaDomain, string(area))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format(periodLayout))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format(periodLayout))
+	return c.requestBalancingMarketDocument(ctx, params)
+}
+
+// 4.6.2. Aggregated Balancing Energy Bids [GL EB 12.3.E]
+func (c *EntsoeClient) GetAggregatedBalancingEnergyBids(
+	processType ProcessType,
+	area DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	return c.GetAggregatedBalancingEnergyBidsContext(context.Background(), processType, area, periodStart, periodEnd)
+}
+
+// GetAggregatedBalancingEnergyBidsContext is like GetAggregatedBalancingEnergyBids but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetAggregatedBalancingEnergyBidsContext(
+	ctx context.Context,
+	processType ProcessType,
+	area DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeBidDocument))
+	params.Add(ParameterProcessType, string(processType))
+	params.Add(ParameterAreaDomain, string(area))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format(periodLayout))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format(periodLayout))
+	return c.requestBalancingMarketDocument(ctx, params)
+}
+
+// 4.6.3. Prices of Activated Balancing Energy [GL EB 12.3.F]
+func (c *EntsoeClient) GetActivatedBalancingEnergyPricesByProcess(
+	processType ProcessType,
+	area DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	return c.GetActivatedBalancingEnergyPricesByProcessContext(context.Background(), processType, area, periodStart, periodEnd)
+}
+
+// GetActivatedBalancingEnergyPricesByProcessContext is like GetActivatedBalancingEnergyPricesByProcess but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetActivatedBalancingEnergyPricesByProcessContext(
+	ctx context.Context,
+	processType ProcessType,
+	area DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeAcquiringSystemOperatorReserveSchedule))
+	params.Add(ParameterProcessType, string(processType))
+	params.Add(ParameterAreaDomain, string(area))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format(periodLayout))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format(periodLayout))
+	return c.requestBalancingMarketDocument(ctx, params)
+}
+
+// 4.6.4. Use of Allocated Cross-Zonal Balancing Capacity [GL EB 12.3.H&I]
+func (c *EntsoeClient) GetUseOfAllocatedCrossZonalBalancingCapacity(
+	processType ProcessType,
+	acquiringDomain DomainType,
+	connectingDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	return c.GetUseOfAllocatedCrossZonalBalancingCapacityContext(context.Background(), processType, acquiringDomain, connectingDomain, periodStart, periodEnd)
+}
+
+// GetUseOfAllocatedCrossZonalBalancingCapacityContext is like GetUseOfAllocatedCrossZonalBalancingCapacity but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetUseOfAllocatedCrossZonalBalancingCapacityContext(
+	ctx context.Context,
+	processType ProcessType,
+	acquiringDomain DomainType,
+	connectingDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeReserveAllocationResultDocument))
+	params.Add(ParameterProcessType, string(processType))
+	params.Add(ParameterAcquiringDomain, string(acquiringDomain))
+	params.Add(ParameterConnectingDomain, string(connectingDomain))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format(periodLayout))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format(periodLayout))
+	return c.requestBalancingMarketDocument(ctx, params)
+}
+
+// 4.6.5. Amount of Balancing Reserves Under Contract [17.1.B]
+func (c *EntsoeClient) GetAmountOfBalancingReservesUnderContract(
+	typeMarketAgreement ContractMarketAgreementType,
+	businessType BusinessType,
+	controlArea DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	psrType *PsrType,
+) (*BalancingMarketDocument, error) {
+	return c.GetAmountOfBalancingReservesUnderContractContext(context.Background(), typeMarketAgreement, businessType, controlArea, periodStart, periodEnd, psrType)
+}
+
+// GetAmountOfBalancingReservesUnderContractContext is like GetAmountOfBalancingReservesUnderContract but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetAmountOfBalancingReservesUnderContractContext(
+	ctx context.Context,
+	typeMarketAgreement ContractMarketAgreementType,
+	businessType BusinessType,
+	controlArea DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	psrType *PsrType,
+) (*BalancingMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeContractedReserves))
+	params.Add(ParameterTypeMarketAgreementType, string(typeMarketAgreement))
+	params.Add(ParameterBusinessType, string(businessType))
+	params.Add(ParameterControlAreaDomain, string(controlArea))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format(periodLayout))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format(periodLayout))
+	if psrType != nil {
+		params.Add(ParameterPsrType, string(*psrType))
+	}
+	return c.requestBalancingMarketDocument(ctx, params)
+}
+
+// 4.6.6. Prices of Procured Balancing Reserves [17.1.C]
+func (c *EntsoeClient) GetPricesOfProcuredBalancingReserves(
+	typeMarketAgreement ContractMarketAgreementType,
+	controlArea DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	businessType *BusinessType,
+) (*BalancingMarketDocument, error) {
+	return c.GetPricesOfProcuredBalancingReservesContext(context.Background(), typeMarketAgreement, controlArea, periodStart, periodEnd, businessType)
+}
+
+// GetPricesOfProcuredBalancingReservesContext is like GetPricesOfProcuredBalancingReserves but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetPricesOfProcuredBalancingReservesContext(
+	ctx context.Context,
+	typeMarketAgreement ContractMarketAgreementType,
+	controlArea DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	businessType *BusinessType,
+) (*BalancingMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeContractedReservePrices))
+	params.Add(ParameterTypeMarketAgreementType, string(typeMarketAgreement))
+	params.Add(ParameterControlAreaDomain, string(controlArea))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format(periodLayout))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format(periodLayout))
+	if businessType != nil {
+		params.Add(ParameterBusinessType, string(*businessType))
+	}
+	return c.requestBalancingMarketDocument(ctx, params)
+}
+
+// 4.6.7. Accepted Aggregated Offers [17.1.D]
+func (c *EntsoeClient) GetAcceptedAggregatedOffers(
+	controlArea DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	businessType *BusinessType,
+) (*BalancingMarketDocument, error) {
+	return c.GetAcceptedAggregatedOffersContext(context.Background(), controlArea, periodStart, periodEnd, businessType)
+}
+
+// GetAcceptedAggregatedOffersContext is like GetAcceptedAggregatedOffers but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetAcceptedAggregatedOffersContext(
+	ctx context.Context,
+	controlArea DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	businessType *BusinessType,
+) (*BalancingMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeAcceptedOffers))
+	params.Add(ParameterControlAreaDomain, string(controlArea))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format(periodLayout))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format(periodLayout))
+	if businessType != nil {
+		params.Add(ParameterBusinessType, string(*businessType))
+	}
+	return c.requestBalancingMarketDocument(ctx, params)
+}
+
+// 4.6.8. Activated Balancing Energy [17.1.E]
+func (c *EntsoeClient) GetActivatedBalancingEnergy(
+	controlArea DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	businessType *BusinessType,
+) (*BalancingMarketDocument, error) {
+	return c.GetActivatedBalancingEnergyContext(context.Background(), controlArea, periodStart, periodEnd, businessType)
+}
+
+// GetActivatedBalancingEnergyContext is like GetActivatedBalancingEnergy but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetActivatedBalancingEnergyContext(
+	ctx context.Context,
+	controlArea DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	businessType *BusinessType,
+) (*BalancingMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeActivatedBalancingQuantities))
+	params.Add(ParameterControlAreaDomain, string(controlArea))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format(periodLayout))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format(periodLayout))
+	if businessType != nil {
+		params.Add(ParameterBusinessType, string(*businessType))
+	}
+	return c.requestBalancingMarketDocument(ctx, params)
+}
+
+// 4.6.9. Prices of Activated Balancing Energy [17.1.F]
+func (c *EntsoeClient) GetActivatedBalancingEnergyPrices(
+	controlArea DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	businessType *BusinessType,
+) (*BalancingMarketDocument, error) {
+	return c.GetActivatedBalancingEnergyPricesContext(context.Background(), controlArea, periodStart, periodEnd, businessType)
+}
+
+// GetActivatedBalancingEnergyPricesContext is like GetActivatedBalancingEnergyPrices but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetActivatedBalancingEnergyPricesContext(
+	ctx context.Context,
+	controlArea DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	businessType *BusinessType,
+) (*BalancingMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeActivatedBalancingPrices))
+	params.Add(ParameterControlAreaDomain, string(controlArea))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format(periodLayout))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format(periodLayout))
+	if businessType != nil {
+		params.Add(ParameterBusinessType, string(*businessType))
+	}
+	return c.requestBalancingMarketDocument(ctx, params)
+}
+
+// 4.6.10. Imbalance Prices [17.1.G]
+func (c *EntsoeClient) GetImbalancePrices(
+	controlArea DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	return c.GetImbalancePricesContext(context.Background(), controlArea, periodStart, periodEnd)
+}
+
+// GetImbalancePricesContext is like GetImbalancePrices but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetImbalancePricesContext(
+	ctx context.Context,
+	controlArea DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeImbalancePrices))
+	params.Add(ParameterControlAreaDomain, string(controlArea))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format(periodLayout))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format(periodLayout))
+	return c.requestBalancingMarketDocument(ctx, params)
+}
+
+// 4.6.11. Total Imbalance Volumes [17.1.H]
+func (c *EntsoeClient) GetTotalImbalanceVolumes(
+	controlArea DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	return c.GetTotalImbalanceVolumesContext(context.Background(), controlArea, periodStart, periodEnd)
+}
+
+// GetTotalImbalanceVolumesContext is like GetTotalImbalanceVolumes but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetTotalImbalanceVolumesContext(
+	ctx context.Context,
+	controlArea DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeImbalanceVolume))
+	params.Add(ParameterControlAreaDomain, string(controlArea))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format(periodLayout))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format(periodLayout))
+	return c.requestBalancingMarketDocument(ctx, params)
+}
+
+// 4.6.12. Financial Expenses and Income for Balancing [17.1.I]
+func (c *EntsoeClient) GetFinancialExpensesAndIncomeForBalancing(
+	controlArea DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	return c.GetFinancialExpensesAndIncomeForBalancingContext(context.Background(), controlArea, periodStart, periodEnd)
+}
+
+// GetFinancialExpensesAndIncomeForBalancingContext is like GetFinancialExpensesAndIncomeForBalancing but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetFinancialExpensesAndIncomeForBalancingContext(
+	ctx context.Context,
+	controlArea DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeFinancialSituation))
+	params.Add(ParameterControlAreaDomain, string(controlArea))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format(periodLayout))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format(periodLayout))
+	return c.requestBalancingMarketDocument(ctx, params)
+}
+
+// 4.6.13. Cross-border Balancing [17.1.J]
+func (c *EntsoeClient) GetCrossBorderBalancing(
+	acquiringDomain DomainType,
+	connectingDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	return c.GetCrossBorderBalancingContext(context.Background(), acquiringDomain, connectingDomain, periodStart, periodEnd)
+}
+
+// GetCrossBorderBalancingContext is like GetCrossBorderBalancing but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetCrossBorderBalancingContext(
+	ctx context.Context,
+	acquiringDomain DomainType,
+	connectingDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeCrossBorderBalancing))
+	params.Add(ParameterAcquiringDomain, string(acquiringDomain))
+	params.Add(ParameterConnectingDomain, string(connectingDomain))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format(periodLayout))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format(periodLayout))
+	return c.requestBalancingMarketDocument(ctx, params)
+}
+
+// 4.6.14. FCR Total capacity [SO GL 187.2]
+func (c *EntsoeClient) GetFCRTotalCapacity(
+	area DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	return c.GetFCRTotalCapacityContext(context.Background(), area, periodStart, periodEnd)
+}
+
+// GetFCRTotalCapacityContext is like GetFCRTotalCapacity but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetFCRTotalCapacityContext(
+	ctx context.Context,
+	area DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeCapacityDocument))
+	params.Add(ParameterBusinessType, string(BusinessTypeGeneralCapacityInformation))
+	params.Add(ParameterAreaDomain, string(area))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format(periodLayout))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format(periodLayout))
+	return c.requestBalancingMarketDocument(ctx, params)
+}
+
+// 4.6.15. Shares of FCR capacity - share of capacity [SO GL 187.2]
+func (c *EntsoeClient) GetSharesOfFCRCapacity(
+	area DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	return c.GetSharesOfFCRCapacityContext(context.Background(), area, periodStart, periodEnd)
+}
+
+// GetSharesOfFCRCapacityContext is like GetSharesOfFCRCapacity but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetSharesOfFCRCapacityContext(
+	ctx context.Context,
+	area DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeCapacityDocument))
+	params.Add(ParameterBusinessType, string(BusinessTypeShareOfReserveCapacity))
+	params.Add(ParameterAreaDomain, string(area))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format(periodLayout))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format(periodLayout))
+	return c.requestBalancingMarketDocument(ctx, params)
+}
+
+// 4.6.16. Shares of FCR capacity - contracted reserve capacity [SO GL 187.2]
+func (c *EntsoeClient) GetContractedFCRReserveCapacity(
+	area DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	return c.GetContractedFCRReserveCapacityContext(context.Background(), area, periodStart, periodEnd)
+}
+
+// GetContractedFCRReserveCapacityContext is like GetContractedFCRReserveCapacity but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetContractedFCRReserveCapacityContext(
+	ctx context.Context,
+	area DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeCapacityDocument))
+	params.Add(ParameterBusinessType, string(BusinessTypeProcuredCapacity))
+	params.Add(ParameterAreaDomain, string(area))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format(periodLayout))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format(periodLayout))
+	return c.requestBalancingMarketDocument(ctx, params)
+}
+
+// 4.6.17. FRR Actual Capacity [SO GL 188.4]
+func (c *EntsoeClient) GetFRRActualCapacity(
+	area DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	return c.GetFRRActualCapacityContext(context.Background(), area, periodStart, periodEnd)
+}
+
+// GetFRRActualCapacityContext is like GetFRRActualCapacity but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetFRRActualCapacityContext(
+	ctx context.Context,
+	area DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeCapacityDocument))
+	params.Add(ParameterProcessType, string(ProcessTypeFrequencyRestorationReserve))
+	params.Add(ParameterBusinessType, string(BusinessTypeActualReserveCapacity))
+	params.Add(ParameterAreaDomain, string(area))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format(periodLayout))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format(periodLayout))
+	return c.requestBalancingMarketDocument(ctx, params)
+}
+
+// 4.6.18. RR Actual Capacity [SO GL 189.3]
+func (c *EntsoeClient) GetRRActualCapacity(
+	area DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	return c.GetRRActualCapacityContext(context.Background(), area, periodStart, periodEnd)
+}
+
+// GetRRActualCapacityContext is like GetRRActualCapacity but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetRRActualCapacityContext(
+	ctx context.Context,
+	area DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeCapacityDocument))
+	params.Add(ParameterProcessType, string(ProcessTypeReplacementReserve))
+	params.Add(ParameterBusinessType, string(BusinessTypeActualReserveCapacity))
+	params.Add(ParameterAreaDomain, string(area))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format(periodLayout))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format(periodLayout))
+	return c.requestBalancingMarketDocument(ctx, params)
+}
+
+// 4.6.19. Sharing of RR and FRR [SO GL 190.1]
+func (c *EntsoeClient) GetSharingOfRRAndFRR(
+	processType ProcessType,
+	acquiringDomain DomainType,
+	connectingDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	return c.GetSharingOfRRAndFRRContext(context.Background(), processType, acquiringDomain, connectingDomain, periodStart, periodEnd)
+}
+
+// GetSharingOfRRAndFRRContext is like GetSharingOfRRAndFRR but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetSharingOfRRAndFRRContext(
+	ctx context.Context,
+	processType ProcessType,
+	acquiringDomain DomainType,
+	connectingDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeCapacityDocument))
+	params.Add(ParameterProcessType, string(processType))
+	params.Add(ParameterBusinessType, string(BusinessTypeSharedBalancingReserveCapacity))
+	params.Add(ParameterAcquiringDomain, string(acquiringDomain))
+	params.Add(ParameterConnectingDomain, string(connectingDomain))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format(periodLayout))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format(periodLayout))
+	return c.requestBalancingMarketDocument(ctx, params)
+}
+
+// 4.7. Outages domain
+//
+// Outage documents are returned as a zip archive holding one document per outage,
+// at most UnavailabilityPageSize of them per request, see UnavailabilityFilter.Offset.
+
+// UnavailabilityPageSize is the maximum number of documents returned by one outage request.
+const UnavailabilityPageSize = 200
+
+// UnavailabilityFilter holds the optional parameters of the outage endpoints,
+// nil fields are not sent.
+type UnavailabilityFilter struct {
+	BusinessType       *BusinessType // planned maintenance (A53) or unplanned outage (A54)
+	DocStatus          *DocStatus    // active (A05), cancelled (A09) or withdrawn (A13)
+	MRID               *string       // mRID of a given outage, all its versions are returned
+	RegisteredResource *string       // EIC code of a production or generation unit
+	PeriodStartUpdate  *time.Time    // only outages updated after this instant
+	PeriodEndUpdate    *time.Time    // only outages updated before this instant
+	Offset             *int          // pagination, a multiple of UnavailabilityPageSize up to 4800
+}
+
+func (f *UnavailabilityFilter) addTo(params url.Values) {
+	if f == nil {
+		return
+	}
+	if f.BusinessType != nil {
+		params.Add(ParameterBusinessType, string(*f.BusinessType))
+	}
+	if f.DocStatus != nil {
+		params.Add(ParameterDocStatus, string(*f.DocStatus))
+	}
+	if f.MRID != nil {
+		params.Add(ParameterMRID, *f.MRID)
+	}
+	if f.RegisteredResource != nil {
+		params.Add(ParameterRegisteredResource, *f.RegisteredResource)
+	}
+	if f.PeriodStartUpdate != nil {
+		params.Add(ParameterPeriodStartUpdate, f.PeriodStartUpdate.UTC().Format(periodLayout))
+	}
+	if f.PeriodEndUpdate != nil {
+		params.Add(ParameterPeriodEndUpdate, f.PeriodEndUpdate.UTC().Format(periodLayout))
+	}
+	if f.Offset != nil {
+		params.Add(ParameterOffset, strconv.Itoa(*f.Offset))
+	}
+}
+
+// 4.7.1. Unavailability of Consumption Units [7.1A&B]
+func (c *EntsoeClient) GetUnavailabilityOfConsumptionUnits(
+	biddingZone DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	filter *UnavailabilityFilter,
+) ([]*UnavailabilityMarketDocument, error) {
+	return c.GetUnavailabilityOfConsumptionUnitsContext(context.Background(), biddingZone, periodStart, periodEnd, filter)
+}
+
+// GetUnavailabilityOfConsumptionUnitsContext is like GetUnavailabilityOfConsumptionUnits but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetUnavailabilityOfConsumptionUnitsContext(
+	ctx context.Context,
+	biddingZone DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	filter *UnavailabilityFilter,
+) ([]*UnavailabilityMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeLoadUnavailability))
+	params.Add(ParameterBiddingZoneDomain, string(biddingZone))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format(periodLayout))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format(periodLayout))
+	filter.addTo(params)
+	return c.requestUnavailabilityMarketDocuments(ctx, params)
+}
+
+// 4.7.2. Unavailability of Transmission Infrastructure [10.1.A&B]
+func (c *EntsoeClient) GetUnavailabilityOfTransmissionInfrastructure(
+	inDomain DomainType,
+	outDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	filter *UnavailabilityFilter,
+) ([]*UnavailabilityMarketDocument, error) {
+	return c.GetUnavailabilityOfTransmissionInfrastructureContext(context.Background(), inDomain, outDomain, periodStart, periodEnd, filter)
+}
+
+// GetUnavailabilityOfTransmissionInfrastructureContext is like GetUnavailabilityOfTransmissionInfrastructure but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetUnavailabilityOfTransmissionInfrastructureContext(
+	ctx context.Context,
+	inDomain DomainType,
+	outDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	filter *UnavailabilityFilter,
+) ([]*UnavailabilityMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeTransmissionUnavailability))
+	params.Add(ParameterInDomain, string(inDomain))
+	params.Add(ParameterOutDomain, string(outDomain))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format(periodLayout))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format(periodLayout))
+	filter.addTo(params)
+	return c.requestUnavailabilityMarketDocuments(ctx, params)
+}
+
+// 4.7.3. Unavailability of Offshore Grid Infrastructure [10.1.C]
+func (c *EntsoeClient) GetUnavailabilityOfOffshoreGridInfrastructure(
+	biddingZone DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	filter *UnavailabilityFilter,
+) ([]*UnavailabilityMarketDocument, error) {
+	return c.GetUnavailabilityOfOffshoreGridInfrastructureContext(context.Background(), biddingZone, periodStart, periodEnd, filter)
+}
+
+// GetUnavailabilityOfOffshoreGridInfrastructureContext is like GetUnavailabilityOfOffshoreGridInfrastructure but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetUnavailabilityOfOffshoreGridInfrastructureContext(
+	ctx context.Context,
+	biddingZone DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	filter *UnavailabilityFilter,
+) ([]*UnavailabilityMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeOffshoreGridInfrastructureUnavailability))
+	params.Add(ParameterBiddingZoneDomain, string(biddingZone))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format(periodLayout))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format(periodLayout))
+	filter.addTo(params)
+	return c.requestUnavailabilityMarketDocuments(ctx, params)
+}
+
+// 4.7.4. Unavailability of Generation Units [15.1.A&B]
+func (c *EntsoeClient) GetUnavailabilityOfGenerationUnits(
+	biddingZone DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	filter *UnavailabilityFilter,
+) ([]*UnavailabilityMarketDocument, error) {
+	return c.GetUnavailabilityOfGenerationUnitsContext(context.Background(), biddingZone, periodStart, periodEnd, filter)
+}
+
+// GetUnavailabilityOfGenerationUnitsContext is like GetUnavailabilityOfGenerationUnits but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetUnavailabilityOfGenerationUnitsContext(
+	ctx context.Context,
+	biddingZone DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	filter *UnavailabilityFilter,
+) ([]*UnavailabilityMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeGenerationUnavailability))
+	params.Add(ParameterBiddingZoneDomain, string(biddingZone))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format(periodLayout))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format(periodLayout))
+	filter.addTo(params)
+	return c.requestUnavailabilityMarketDocuments(ctx, params)
+}
+
+// 4.7.5. Unavailability of Production Units [15.1.C&D]
+func (c *EntsoeClient) GetUnavailabilityOfProductionUnits(
+	biddingZone DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	filter *UnavailabilityFilter,
+) ([]*UnavailabilityMarketDocument, error) {
+	return c.GetUnavailabilityOfProductionUnitsContext(context.Background(), biddingZone, periodStart, periodEnd, filter)
+}
+
+// GetUnavailabilityOfProductionUnitsContext is like GetUnavailabilityOfProductionUnits but uses ctx for the HTTP request.
+func (c *EntsoeClient) GetUnavailabilityOfProductionUnitsContext(
+	ctx context.Context,
+	biddingZone DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	filter *UnavailabilityFilter,
+) ([]*UnavailabilityMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeProductionUnavailability))
+	params.Add(ParameterBiddingZoneDomain, string(biddingZone))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format(periodLayout))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format(periodLayout))
+	filter.addTo(params)
+	return c.requestUnavailabilityMarketDocuments(ctx, params)
+}
+
+func (c *EntsoeClient) requestGLMarketDocument(ctx context.Context, params url.Values) (*GLMarketDocument, error) {
+	docs, err := c.requestGLMarketDocuments(ctx, params)
 	if err != nil {
 		return nil, err
 	}
 
-	var doc TransmissionNetworkMarketDocument
-	err = xml.Unmarshal(data, &doc)
+	// merge the time series of zipped responses into the first document
+	doc := docs[0]
+	for _, d := range docs[1:] {
+		doc.TimeSeries = append(doc.TimeSeries, d.TimeSeries...)
+	}
+	return doc, nil
+}
+
+func (c *EntsoeClient) requestGLMarketDocuments(ctx context.Context, params url.Values) ([]*GLMarketDocument, error) {
+	parts, err := c.sendRequest(ctx, params)
 	if err != nil {
-		return nil, generateParsingError(data)
+		return nil, err
 	}
-	return &doc, nil
+	if len(parts) == 0 {
+		return nil, fmt.Errorf("Error requesting data: empty zip response")
+	}
+
+	docs := make([]*GLMarketDocument, 0, len(parts))
+	for _, data := range parts {
+		var doc GLMarketDocument
+		err = xml.Unmarshal(data, &doc)
+		if err != nil {
+			return nil, generateParsingError(params, data)
+		}
+		docs = append(docs, &doc)
+	}
+	return docs, nil
 }
 
-func (c *EntsoeClient) requestPublicationMarketDocument(params url.Values) (*PublicationMarketDocument, error) {
-	paramStr := params.Encode()
-	data, err := c.sendRequest(paramStr)
+func (c *EntsoeClient) requestTransmissionNetworkMarketDocument(ctx context.Context, params url.Values) (*TransmissionNetworkMarketDocument, error) {
+	docs, err := c.requestTransmissionNetworkMarketDocuments(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// merge the time series of zipped responses into the first document
+	doc := docs[0]
+	for _, d := range docs[1:] {
+		doc.TimeSeries = append(doc.TimeSeries, d.TimeSeries...)
+	}
+	return doc, nil
+}
+
+func (c *EntsoeClient) requestTransmissionNetworkMarketDocuments(ctx context.Context, params url.Values) ([]*TransmissionNetworkMarketDocument, error) {
+	parts, err := c.sendRequest(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	if len(parts) == 0 {
+		return nil, fmt.Errorf("Error requesting data: empty zip response")
+	}
 
-	var doc PublicationMarketDocument
-	err = xml.Unmarshal(data, &doc)
+	docs := make([]*TransmissionNetworkMarketDocument, 0, len(parts))
+	for _, data := range parts {
+		var doc TransmissionNetworkMarketDocument
+		err = xml.Unmarshal(data, &doc)
+		if err != nil {
+			return nil, generateParsingError(params, data)
+		}
+		docs = append(docs, &doc)
+	}
+	return docs, nil
+}
+
+func (c *EntsoeClient) requestPublicationMarketDocument(ctx context.Context, params url.Values) (*PublicationMarketDocument, error) {
+	docs, err := c.requestPublicationMarketDocuments(ctx, params)
 	if err != nil {
-		return nil, generateParsingError(data)
+		return nil, err
 	}
-	return &doc, nil
+
+	// merge the time series of zipped responses into the first document
+	doc := docs[0]
+	for _, d := range docs[1:] {
+		doc.TimeSeries = append(doc.TimeSeries, d.TimeSeries...)
+	}
+	return doc, nil
 }
 
-func (c *EntsoeClient) requestCriticalNetworkElementMarketDocument(params url.Values) (*CriticalNetworkElementMarketDocument, error) {
-	paramStr := params.Encode()
-	data, err := c.sendRequest(paramStr)
+func (c *EntsoeClient) requestPublicationMarketDocuments(ctx context.Context, params url.Values) ([]*PublicationMarketDocument, error) {
+	parts, err := c.sendRequest(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	if len(parts) == 0 {
+		return nil, fmt.Errorf("Error requesting data: empty zip response")
+	}
 
-	var doc CriticalNetworkElementMarketDocument
-	err = xml.Unmarshal(data, &doc)
+	docs := make([]*PublicationMarketDocument, 0, len(parts))
+	for _, data := range parts {
+		var doc PublicationMarketDocument
+		err = xml.Unmarshal(data, &doc)
+		if err != nil {
+			return nil, generateParsingError(params, data)
+		}
+		docs = append(docs, &doc)
+	}
+	return docs, nil
+}
+
+func (c *EntsoeClient) requestCriticalNetworkElementMarketDocument(ctx context.Context, params url.Values) (*CriticalNetworkElementMarketDocument, error) {
+	docs, err := c.requestCriticalNetworkElementMarketDocuments(ctx, params)
 	if err != nil {
-		return nil, generateParsingError(data)
+		return nil, err
 	}
-	return &doc, nil
+
+	// merge the time series of zipped responses into the first document
+	doc := docs[0]
+	for _, d := range docs[1:] {
+		doc.TimeSeries = append(doc.TimeSeries, d.TimeSeries...)
+	}
+	return doc, nil
+}
+
+func (c *EntsoeClient) requestCriticalNetworkElementMarketDocuments(ctx context.Context, params url.Values) ([]*CriticalNetworkElementMarketDocument, error) {
+	parts, err := c.sendRequest(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	if len(parts) == 0 {
+		return nil, fmt.Errorf("Error requesting data: empty zip response")
+	}
+
+	docs := make([]*CriticalNetworkElementMarketDocument, 0, len(parts))
+	for _, data := range parts {
+		var doc CriticalNetworkElementMarketDocument
+		err = xml.Unmarshal(data, &doc)
+		if err != nil {
+			return nil, generateParsingError(params, data)
+		}
+		docs = append(docs, &doc)
+	}
+	return docs, nil
+}
+
+func (c *EntsoeClient) requestBalancingMarketDocument(ctx context.Context, params url.Values) (*BalancingMarketDocument, error) {
+	docs, err := c.requestBalancingMarketDocuments(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// merge the time series of zipped responses into the first document
+	doc := docs[0]
+	for _, d := range docs[1:] {
+		doc.TimeSeries = append(doc.TimeSeries, d.TimeSeries...)
+	}
+	return doc, nil
+}
+
+func (c *EntsoeClient) requestBalancingMarketDocuments(ctx context.Context, params url.Values) ([]*BalancingMarketDocument, error) {
+	parts, err := c.sendRequest(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	if len(parts) == 0 {
+		return nil, fmt.Errorf("Error requesting data: empty zip response")
+	}
+
+	docs := make([]*BalancingMarketDocument, 0, len(parts))
+	for _, data := range parts {
+		var doc BalancingMarketDocument
+		err = xml.Unmarshal(data, &doc)
+		if err != nil {
+			return nil, generateParsingError(params, data)
+		}
+		docs = append(docs, &doc)
+	}
+	return docs, nil
+}
+
+func (c *EntsoeClient) requestUnavailabilityMarketDocuments(ctx context.Context, params url.Values) ([]*UnavailabilityMarketDocument, error) {
+	parts, err := c.sendRequest(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	docs := make([]*UnavailabilityMarketDocument, 0, len(parts))
+	for _, data := range parts {
+		var doc UnavailabilityMarketDocument
+		err = xml.Unmarshal(data, &doc)
+		if err != nil {
+			return nil, generateParsingError(params, data)
+		}
+		docs = append(docs, &doc)
+	}
+	return docs, nil
 }
 
-func generateParsingError(data []byte) error {
+func (c *EntsoeClient) requestConfigurationMarketDocument(ctx context.Context, params url.Values) (*ConfigurationMarketDocument, error) {
+	parts, err := c.sendRequest(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	if len(parts) == 0 {
+		return nil, fmt.Errorf("Error requesting data: empty zip response")
+	}
+
+	// master data may be zipped too, merge the time series into the first document
+	var doc *ConfigurationMarketDocument
+	for _, data := range parts {
+		var d ConfigurationMarketDocument
+		err = decodeXML(data, &d)
+		if err != nil {
+			return nil, generateParsingError(params, data)
+		}
+		if doc == nil {
+			doc = &d
+		} else {
+			doc.TimeSeries = append(doc.TimeSeries, d.TimeSeries...)
+		}
+	}
+	return doc, nil
+}
+
+func generateParsingError(params url.Values, data []byte) error {
 	d, err := parseAcknowledgementMarketDocument(data)
 	if err != nil {
 		return err
 	}
-	return fmt.Errorf("Error requesting data: %s", d.Reason.Text)
+	return newAPIError(http.StatusOK, params, d)
 }
 
 func parseAcknowledgementMarketDocument(data []byte) (*AcknowledgementMarketDocument, error) {
@@ -732,16 +2001,88 @@ func parseAcknowledgementMarketDocument(data []byte) (*AcknowledgementMarketDocu
 	return &doc, nil
 }
 
-func (c *EntsoeClient) sendRequest(paramStr string) ([]byte, error) {
-	resp, err := http.Get("https://web-api.tp.entsoe.eu/api?securityToken=" + c.apiKey + "&" + paramStr)
-	if err != nil {
+// sendRequest returns the XML documents of the response, several of them when
+// the platform answers with a zip archive.
+func (c *EntsoeClient) sendRequest(ctx context.Context, params url.Values) ([][]byte, error) {
+	if err := validateDomains(params); err != nil {
 		return nil, err
 	}
+
+	paramStr := params.Encode()
+	for attempt := 1; ; attempt++ {
+		if c.limiter != nil {
+			if err := c.limiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		resp, body, err := c.doRequest(ctx, paramStr)
+		if err == nil && resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			return unpackResponse(resp.Header.Get("Content-Type"), body, c.maxDecompressedSize)
+		}
+
+		status := 0
+		var header http.Header
+		if resp != nil {
+			status = resp.StatusCode
+			header = resp.Header
+		}
+
+		if attempt >= c.retryPolicy.attempts() || ctx.Err() != nil || !isRetryable(status, err) {
+			if err != nil {
+				return nil, err
+			}
+			return nil, checkStatus(status, params, body)
+		}
+
+		wait := c.retryPolicy.backoff(attempt)
+		if retryAfter, ok := parseRetryAfter(header); ok {
+			if c.retryPolicy.MaxDelay > 0 && retryAfter > c.retryPolicy.MaxDelay {
+				logger.Warn().
+					Str("documentType", params.Get(ParameterDocumentType)).
+					Int("status", status).
+					Dur("retry_after", retryAfter).
+					Msg("Retry-After exceeds max retry delay, giving up")
+				return nil, checkStatus(status, params, body)
+			}
+			wait = retryAfter
+		}
+
+		logger.Warn().
+			Err(err).
+			Str("documentType", params.Get(ParameterDocumentType)).
+			Int("status", status).
+			Int("attempt", attempt).
+			Dur("backoff", wait).
+			Msg("Retrying request")
+
+		if err := sleepContext(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (c *EntsoeClient) doRequest(ctx context.Context, paramStr string) (*http.Response, []byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"?securityToken="+c.apiKey+"&"+paramStr, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		// keep the security token out of error messages and logs
+		if urlErr, ok := err.(*url.Error); ok {
+			urlErr.URL = c.baseURL
+		}
+		return nil, nil, err
+	}
 	body := resp.Body
 	defer body.Close()
 	bodyBytes, err := io.ReadAll(body)
 	if err != nil {
-		return nil, err
+		return resp, nil, err
 	}
-	return bodyBytes, nil
+	return resp, bodyBytes, nil
 }
@@ -509,6 +509,341 @@ func TestAggregatedFillingRateOfWaterReservoirsAndHydroStoragePlants(t *testing.
 	assert.Nil(t, err)
 }
 
+// 4.5. Master Data
+
+// 4.5.1. Production and Generation Units
+func TestGetProductionAndGenerationUnits(t *testing.T) {
+	c := NewEntsoeClientFromEnv()
+	doc, err := c.GetProductionAndGenerationUnits(
+		DomainCZ,
+		genTime("201701010000"),
+		nil,
+	)
+	assert.NotNil(t, doc)
+	assert.Nil(t, err)
+}
+
+// 4.6. Balancing domain
+
+// 4.6.1. Current Balancing State [GL EB 12.3.A]
+func TestGetCurrentBalancingState(t *testing.T) {
+	c := NewEntsoeClientFromEnv()
+	doc, err := c.GetCurrentBalancingState(
+		DomainCZ,
+		genTime("201912190000"),
+		genTime("201912190010"),
+	)
+	assert.NotNil(t, doc)
+	assert.Nil(t, err)
+}
+
+// 4.6.2. Aggregated Balancing Energy Bids [GL EB 12.3.E]
+func TestGetAggregatedBalancingEnergyBids(t *testing.T) {
+	c := NewEntsoeClientFromEnv()
+	doc, err := c.GetAggregatedBalancingEnergyBids(
+		ProcessTypeAutomaticFrequencyRestorationReserve,
+		DomainCZ,
+		genTime("201912161300"),
+		genTime("201912161800"),
+	)
+	assert.NotNil(t, doc)
+	assert.Nil(t, err)
+}
+
+// 4.6.3. Prices of Activated Balancing Energy [GL EB 12.3.F]
+func TestGetActivatedBalancingEnergyPricesByProcess(t *testing.T) {
+	c := NewEntsoeClientFromEnv()
+	doc, err := c.GetActivatedBalancingEnergyPricesByProcess(
+		ProcessTypeAutomaticFrequencyRestorationReserve,
+		DomainCZ,
+		genTime("201912312300"),
+		genTime("202001010000"),
+	)
+	assert.NotNil(t, doc)
+	assert.Nil(t, err)
+}
+
+// 4.6.4. Use of Allocated Cross-Zonal Balancing Capacity [GL EB 12.3.H&I]
+func TestGetUseOfAllocatedCrossZonalBalancingCapacity(t *testing.T) {
+	c := NewEntsoeClientFromEnv()
+	doc, err := c.GetUseOfAllocatedCrossZonalBalancingCapacity(
+		ProcessTypeReplacementReserve,
+		DomainAT,
+		DomainCH,
+		genTime("201912160000"),
+		genTime("201912170000"),
+	)
+	assert.NotNil(t, doc)
+	assert.Nil(t, err)
+}
+
+// 4.6.5. Amount of Balancing Reserves Under Contract [17.1.B]
+func TestGetAmountOfBalancingReservesUnderContract(t *testing.T) {
+	c := NewEntsoeClientFromEnv()
+	psrType := PsrTypeGeneration
+	doc, err := c.GetAmountOfBalancingReservesUnderContract(
+		ContractMarketAgreementTypeHourly,
+		BusinessTypeFrequencyContainmentReserve,
+		DomainCZ,
+		genTime("201512312300"),
+		genTime("201601012300"),
+		&psrType,
+	)
+	assert.NotNil(t, doc)
+	assert.Nil(t, err)
+}
+
+// 4.6.6. Prices of Procured Balancing Reserves [17.1.C]
+func TestGetPricesOfProcuredBalancingReserves(t *testing.T) {
+	c := NewEntsoeClientFromEnv()
+	businessType := BusinessTypeAutomaticFrequencyRestorationReserve
+	doc, err := c.GetPricesOfProcuredBalancingReserves(
+		ContractMarketAgreementTypeDaily,
+		DomainCZ,
+		genTime("201512312300"),
+		genTime("201601012300"),
+		&businessType,
+	)
+	assert.NotNil(t, doc)
+	assert.Nil(t, err)
+}
+
+// 4.6.7. Accepted Aggregated Offers [17.1.D]
+func TestGetAcceptedAggregatedOffers(t *testing.T) {
+	c := NewEntsoeClientFromEnv()
+	businessType := BusinessTypeFrequencyContainmentReserve
+	doc, err := c.GetAcceptedAggregatedOffers(
+		DomainCZ,
+		genTime("201512312300"),
+		genTime("201612312300"),
+		&businessType,
+	)
+	assert.NotNil(t, doc)
+	assert.Nil(t, err)
+}
+
+// 4.6.8. Activated Balancing Energy [17.1.E]
+func TestGetActivatedBalancingEnergy(t *testing.T) {
+	c := NewEntsoeClientFromEnv()
+	businessType := BusinessTypeAutomaticFrequencyRestorationReserve
+	doc, err := c.GetActivatedBalancingEnergy(
+		DomainCZ,
+		genTime("201512312300"),
+		genTime("201612312300"),
+		&businessType,
+	)
+	assert.NotNil(t, doc)
+	assert.Nil(t, err)
+}
+
+// 4.6.9. Prices of Activated Balancing Energy [17.1.F]
+func TestGetActivatedBalancingEnergyPrices(t *testing.T) {
+	c := NewEntsoeClientFromEnv()
+	businessType := BusinessTypeAutomaticFrequencyRestorationReserve
+	doc, err := c.GetActivatedBalancingEnergyPrices(
+		DomainCZ,
+		genTime("201512312300"),
+		genTime("201612312300"),
+		&businessType,
+	)
+	assert.NotNil(t, doc)
+	assert.Nil(t, err)
+}
+
+// 4.6.10. Imbalance Prices [17.1.G]
+func TestGetImbalancePrices(t *testing.T) {
+	c := NewEntsoeClientFromEnv()
+	doc, err := c.GetImbalancePrices(
+		DomainCZ,
+		genTime("201512312300"),
+		genTime("201612312300"),
+	)
+	assert.NotNil(t, doc)
+	assert.Nil(t, err)
+}
+
+// 4.6.11. Total Imbalance Volumes [17.1.H]
+func TestGetTotalImbalanceVolumes(t *testing.T) {
+	c := NewEntsoeClientFromEnv()
+	doc, err := c.GetTotalImbalanceVolumes(
+		DomainCZ,
+		genTime("201512312300"),
+		genTime("201612312300"),
+	)
+	assert.NotNil(t, doc)
+	assert.Nil(t, err)
+}
+
+// 4.6.12. Financial Expenses and Income for Balancing [17.1.I]
+func TestGetFinancialExpensesAndIncomeForBalancing(t *testing.T) {
+	c := NewEntsoeClientFromEnv()
+	doc, err := c.GetFinancialExpensesAndIncomeForBalancing(
+		DomainCZ,
+		genTime("201512312300"),
+		genTime("201612312300"),
+	)
+	assert.NotNil(t, doc)
+	assert.Nil(t, err)
+}
+
+// 4.6.13. Cross-border Balancing [17.1.J]
+func TestGetCrossBorderBalancing(t *testing.T) {
+	c := NewEntsoeClientFromEnv()
+	doc, err := c.GetCrossBorderBalancing(
+		DomainCZ,
+		DomainSK,
+		genTime("201512312300"),
+		genTime("201601010100"),
+	)
+	assert.NotNil(t, doc)
+	assert.Nil(t, err)
+}
+
+// 4.6.14. FCR Total capacity [SO GL 187.2]
+func TestGetFCRTotalCapacity(t *testing.T) {
+	c := NewEntsoeClientFromEnv()
+	doc, err := c.GetFCRTotalCapacity(
+		DomainContinentalEurope,
+		genTime("201812312300"),
+		genTime("201912312300"),
+	)
+	assert.NotNil(t, doc)
+	assert.Nil(t, err)
+}
+
+// 4.6.15. Shares of FCR capacity - share of capacity [SO GL 187.2]
+func TestGetSharesOfFCRCapacity(t *testing.T) {
+	c := NewEntsoeClientFromEnv()
+	doc, err := c.GetSharesOfFCRCapacity(
+		DomainDE50Hertz,
+		genTime("201912312300"),
+		genTime("202012312300"),
+	)
+	assert.NotNil(t, doc)
+	assert.Nil(t, err)
+}
+
+// 4.6.16. Shares of FCR capacity - contracted reserve capacity [SO GL 187.2]
+func TestGetContractedFCRReserveCapacity(t *testing.T) {
+	c := NewEntsoeClientFromEnv()
+	doc, err := c.GetContractedFCRReserveCapacity(
+		DomainDEAmprion,
+		genTime("201912312300"),
+		genTime("202012312300"),
+	)
+	assert.NotNil(t, doc)
+	assert.Nil(t, err)
+}
+
+// 4.6.17. FRR Actual Capacity [SO GL 188.4]
+func TestGetFRRActualCapacity(t *testing.T) {
+	c := NewEntsoeClientFromEnv()
+	doc, err := c.GetFRRActualCapacity(
+		DomainAT,
+		genTime("201912312300"),
+		genTime("202003312200"),
+	)
+	assert.NotNil(t, doc)
+	assert.Nil(t, err)
+}
+
+// 4.6.18. RR Actual Capacity [SO GL 189.3]
+func TestGetRRActualCapacity(t *testing.T) {
+	c := NewEntsoeClientFromEnv()
+	doc, err := c.GetRRActualCapacity(
+		DomainAT,
+		genTime("201912312300"),
+		genTime("202003312200"),
+	)
+	assert.NotNil(t, doc)
+	assert.Nil(t, err)
+}
+
+// 4.6.19. Sharing of RR and FRR [SO GL 190.1]
+func TestGetSharingOfRRAndFRR(t *testing.T) {
+	c := NewEntsoeClientFromEnv()
+	doc, err := c.GetSharingOfRRAndFRR(
+		ProcessTypeFrequencyRestorationReserve,
+		DomainDEDK1LU,
+		DomainAT,
+		genTime("201912312300"),
+		genTime("202012312300"),
+	)
+	assert.NotNil(t, doc)
+	assert.Nil(t, err)
+}
+
+// 4.7. Outages domain
+
+// 4.7.1. Unavailability of Consumption Units [7.1A&B]
+func TestGetUnavailabilityOfConsumptionUnits(t *testing.T) {
+	c := NewEntsoeClientFromEnv()
+	docs, err := c.GetUnavailabilityOfConsumptionUnits(
+		DomainCZ,
+		genTime("201512312300"),
+		genTime("201612312300"),
+		nil,
+	)
+	assert.NotNil(t, docs)
+	assert.Nil(t, err)
+}
+
+// 4.7.2. Unavailability of Transmission Infrastructure [10.1.A&B]
+func TestGetUnavailabilityOfTransmissionInfrastructure(t *testing.T) {
+	c := NewEntsoeClientFromEnv()
+	businessType := BusinessTypePlannedMaintenance
+	docs, err := c.GetUnavailabilityOfTransmissionInfrastructure(
+		DomainCZ,
+		DomainSK,
+		genTime("201512312300"),
+		genTime("201612312300"),
+		&UnavailabilityFilter{BusinessType: &businessType},
+	)
+	assert.NotNil(t, docs)
+	assert.Nil(t, err)
+}
+
+// 4.7.3. Unavailability of Offshore Grid Infrastructure [10.1.C]
+func TestGetUnavailabilityOfOffshoreGridInfrastructure(t *testing.T) {
+	c := NewEntsoeClientFromEnv()
+	docs, err := c.GetUnavailabilityOfOffshoreGridInfrastructure(
+		DomainDETenneTGER,
+		genTime("201512312300"),
+		genTime("201612312300"),
+		nil,
+	)
+	assert.NotNil(t, docs)
+	assert.Nil(t, err)
+}
+
+// 4.7.4. Unavailability of Generation Units [15.1.A&B]
+func TestGetUnavailabilityOfGenerationUnits(t *testing.T) {
+	c := NewEntsoeClientFromEnv()
+	businessType := BusinessTypePlannedMaintenance
+	docs, err := c.GetUnavailabilityOfGenerationUnits(
+		DomainCZ,
+		genTime("201512312300"),
+		genTime("201612312300"),
+		&UnavailabilityFilter{BusinessType: &businessType},
+	)
+	assert.NotNil(t, docs)
+	assert.Nil(t, err)
+}
+
+// 4.7.5. Unavailability of Production Units [15.1.C&D]
+func TestGetUnavailabilityOfProductionUnits(t *testing.T) {
+	c := NewEntsoeClientFromEnv()
+	businessType := BusinessTypePlannedMaintenance
+	docs, err := c.GetUnavailabilityOfProductionUnits(
+		DomainCZ,
+		genTime("201512312300"),
+		genTime("201612312300"),
+		&UnavailabilityFilter{BusinessType: &businessType},
+	)
+	assert.NotNil(t, docs)
+	assert.Nil(t, err)
+}
+
 func genTime(timeString string) time.Time {
 	t, err := time.Parse("200601021504", timeString)
 	if err != nil {
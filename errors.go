@@ -0,0 +1,157 @@
+package entsoe
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Sentinel errors wrapped by *APIError, to be tested with errors.Is.
+var (
+	ErrNoMatchingData    = errors.New("no matching data found")
+	ErrInvalidParameters = errors.New("invalid request parameters")
+	ErrUnauthorized      = errors.New("unauthorized")
+	ErrRateLimited       = errors.New("rate limited")
+	ErrTooManyDocuments  = errors.New("too many documents requested")
+)
+
+// APIError is returned when the platform refuses a request, either with an
+// Acknowledgement_MarketDocument or with a non 2xx HTTP status.
+//
+//	var apiErr *entsoe.APIError
+//	if errors.As(err, &apiErr) { ... }
+//	if errors.Is(err, entsoe.ErrNoMatchingData) { ... }
+type APIError struct {
+	StatusCode int        // HTTP status of the response
+	ReasonCode string     // Reason.code of the first reason of the acknowledgement, usually 999
+	ReasonText string     // Reason.text of every reason of the acknowledgement, joined with "; "
+	Params     url.Values // request parameters, without the security token
+	kind       error
+}
+
+func (e *APIError) Error() string {
+	if e.ReasonText != "" {
+		return fmt.Sprintf("Error requesting data: %s", e.ReasonText)
+	}
+	return fmt.Sprintf("Error requesting data: HTTP %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+// Unwrap returns the sentinel error matching the failure, or nil if it is not classified.
+func (e *APIError) Unwrap() error {
+	return e.kind
+}
+
+func newAPIError(status int, params url.Values, ack *AcknowledgementMarketDocument) *APIError {
+	e := &APIError{
+		StatusCode: status,
+		Params:     params,
+	}
+	if ack != nil {
+		var texts []string
+		for _, reason := range ack.Reason {
+			if e.ReasonCode == "" {
+				e.ReasonCode = reason.Code
+			}
+			if text := strings.TrimSpace(reason.Text); text != "" {
+				texts = append(texts, text)
+			}
+		}
+		e.ReasonText = strings.Join(texts, "; ")
+	}
+	e.kind = classifyError(e.StatusCode, e.ReasonText)
+	return e
+}
+
+// classifyError maps the HTTP status and the acknowledgement text to a sentinel error.
+// The platform answers almost everything with reason code 999, so the text is what matters.
+func classifyError(status int, text string) error {
+	lower := strings.ToLower(text)
+
+	switch {
+	case status == http.StatusUnauthorized || status == http.StatusForbidden,
+		strings.Contains(lower, "unauthorized"),
+		strings.Contains(lower, "security token"):
+		return ErrUnauthorized
+	case status == http.StatusTooManyRequests,
+		strings.Contains(lower, "too many requests"),
+		strings.Contains(lower, "rate limit"):
+		return ErrRateLimited
+	case strings.Contains(lower, "no matching data"):
+		return ErrNoMatchingData
+	case strings.Contains(lower, "exceeds allowed limit"),
+		strings.Contains(lower, "exceeds the allowed"),
+		strings.Contains(lower, "max allowed"):
+		return ErrTooManyDocuments
+	case status == http.StatusBadRequest,
+		strings.Contains(lower, "not valid"),
+		strings.Contains(lower, "invalid"),
+		strings.Contains(lower, "mandatory"),
+		strings.Contains(lower, "combination"),
+		strings.Contains(lower, "is missing"),
+		strings.Contains(lower, "not allowed"):
+		return ErrInvalidParameters
+	}
+	return nil
+}
+
+// ChunkError is the failure of one of the requests a long window is split into.
+type ChunkError struct {
+	From time.Time
+	To   time.Time
+	Err  error
+}
+
+func (e *ChunkError) Error() string {
+	return fmt.Sprintf("%s - %s: %v", e.From.UTC().Format(periodLayout), e.To.UTC().Format(periodLayout), e.Err)
+}
+
+func (e *ChunkError) Unwrap() error {
+	return e.Err
+}
+
+// FetchError gathers the chunks of a fetch which failed, sorted by time.
+// The data of the other chunks is returned along with it.
+// errors.Is and errors.As match any of the chunk errors.
+type FetchError struct {
+	Chunks []*ChunkError
+}
+
+func (e *FetchError) Error() string {
+	msgs := make([]string, len(e.Chunks))
+	for i, c := range e.Chunks {
+		msgs[i] = c.Error()
+	}
+	return fmt.Sprintf("%d chunk(s) failed: %s", len(e.Chunks), strings.Join(msgs, "; "))
+}
+
+func (e *FetchError) Is(target error) bool {
+	for _, c := range e.Chunks {
+		if errors.Is(c, target) {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *FetchError) As(target interface{}) bool {
+	for _, c := range e.Chunks {
+		if errors.As(c, target) {
+			return true
+		}
+	}
+	return false
+}
+
+func newFetchError(chunks []*ChunkError) error {
+	if len(chunks) == 0 {
+		return nil
+	}
+	sort.Slice(chunks, func(i, j int) bool {
+		return chunks[i].From.Before(chunks[j].From)
+	})
+	return &FetchError{Chunks: chunks}
+}
@@ -0,0 +1,78 @@
+package entsoe
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testAcknowledgement(text string) string {
+	return `<?xml version="1.0" encoding="UTF-8"?>
+<Acknowledgement_MarketDocument xmlns="urn:iec62325.351:tc57wg16:451-1:acknowledgementdocument:7:0">
+	<mRID>1</mRID>
+	<Reason>
+		<code>999</code>
+		<text>` + text + `</text>
+	</Reason>
+</Acknowledgement_MarketDocument>`
+}
+
+func TestAPIErrors(t *testing.T) {
+	tests := []struct {
+		status int
+		body   string
+		want   error
+	}{
+		{http.StatusOK, testAcknowledgement("No matching data found for Data item Day-ahead Prices [12.1.D]"), ErrNoMatchingData},
+		{http.StatusBadRequest, testAcknowledgement("The combination of [In_Domain, Out_Domain] is not valid"), ErrInvalidParameters},
+		{http.StatusBadRequest, testAcknowledgement("The amount of requested data exceeds allowed limit. Max allowed: 100 documents."), ErrTooManyDocuments},
+		{http.StatusUnauthorized, "<html>Unauthorized</html>", ErrUnauthorized},
+		{http.StatusTooManyRequests, "", ErrRateLimited},
+	}
+
+	for _, tt := range tests {
+		c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(tt.status)
+			w.Write([]byte(tt.body))
+		}, WithRetryPolicy(NoRetry))
+
+		_, err := c.GetDayAheadPrices(DomainFR, genTime("202603012300"), genTime("202603020100"))
+		assert.True(t, errors.Is(err, tt.want), "%v should be %v", err, tt.want)
+
+		var apiErr *APIError
+		if assert.True(t, errors.As(err, &apiErr)) {
+			assert.Equal(t, tt.status, apiErr.StatusCode)
+			assert.Equal(t, DomainFR, apiErr.Params.Get(ParameterInDomain))
+			assert.Empty(t, apiErr.Params.Get(ParameterSecurityToken))
+		}
+	}
+}
+
+func TestAPIErrorReasons(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<Acknowledgement_MarketDocument xmlns="urn:iec62325.351:tc57wg16:451-1:acknowledgementdocument:7:0">
+	<mRID>1</mRID>
+	<Reason>
+		<code>999</code>
+		<text>The combination of [In_Domain, Out_Domain] is not valid</text>
+	</Reason>
+	<Reason>
+		<code>999</code>
+		<text>Check the documentation</text>
+	</Reason>
+</Acknowledgement_MarketDocument>`))
+	}, WithRetryPolicy(NoRetry))
+
+	_, err := c.GetDayAheadPrices(DomainFR, genTime("202603012300"), genTime("202603020100"))
+	assert.True(t, errors.Is(err, ErrInvalidParameters), err)
+
+	var apiErr *APIError
+	if assert.True(t, errors.As(err, &apiErr)) {
+		assert.Equal(t, "999", apiErr.ReasonCode)
+		assert.Equal(t, "The combination of [In_Domain, Out_Domain] is not valid; Check the documentation", apiErr.ReasonText)
+	}
+}
@@ -0,0 +1,82 @@
+package entsoe
+
+import "fmt"
+
+// testStart and testEnd bound the hour of the test series, the first of 2026-03-02 in France.
+const (
+	testStart = "2026-03-01T23:00Z"
+	testEnd   = "2026-03-02T00:00Z"
+)
+
+// testSeries returns a time series with a single period from testStart to end.
+// businessType and psrType are left out when empty.
+func testSeries(businessType BusinessType, psrType PsrType, end string, resolution ResolutionType, quantities ...float64) string {
+	series := `
+	<TimeSeries>
+		<mRID>1</mRID>`
+	if businessType != "" {
+		series += `
+		<businessType>` + string(businessType) + `</businessType>`
+	}
+	series += `
+		<inBiddingZone_Domain.mRID codingScheme="A01">10YFR-RTE------C</inBiddingZone_Domain.mRID>
+		<quantity_Measure_Unit.name>MAW</quantity_Measure_Unit.name>
+		<curveType>A01</curveType>`
+	if psrType != "" {
+		series += `
+		<MktPSRType>
+			<psrType>` + string(psrType) + `</psrType>
+		</MktPSRType>`
+	}
+	series += `
+		<Period>
+			<timeInterval>
+				<start>` + testStart + `</start>
+				<end>` + end + `</end>
+			</timeInterval>
+			<resolution>` + string(resolution) + `</resolution>`
+	for i, q := range quantities {
+		series += fmt.Sprintf(`
+			<Point>
+				<position>%d</position>
+				<quantity>%g</quantity>
+			</Point>`, i+1, q)
+	}
+	return series + `
+		</Period>
+	</TimeSeries>`
+}
+
+// testGLDocument returns a GL_MarketDocument holding series, created is its createdDateTime, if any.
+func testGLDocument(documentType DocumentType, created string, series ...string) string {
+	doc := `<?xml version="1.0" encoding="UTF-8"?>
+<GL_MarketDocument xmlns="urn:iec62325.351:tc57wg16:451-6:generationloaddocument:3:0">
+	<mRID>1</mRID>
+	<type>` + string(documentType) + `</type>`
+	if created != "" {
+		doc += `
+	<createdDateTime>` + created + `</createdDateTime>`
+	}
+	for _, s := range series {
+		doc += s
+	}
+	return doc + `
+</GL_MarketDocument>`
+}
+
+// testPublicationDocument returns a Publication_MarketDocument of the hour from testStart holding series.
+func testPublicationDocument(documentType DocumentType, series ...string) string {
+	doc := `<?xml version="1.0" encoding="UTF-8"?>
+<Publication_MarketDocument xmlns="urn:iec62325.351:tc57wg16:451-3:publicationdocument:7:3">
+	<mRID>1</mRID>
+	<type>` + string(documentType) + `</type>
+	<period.timeInterval>
+		<start>` + testStart + `</start>
+		<end>` + testEnd + `</end>
+	</period.timeInterval>`
+	for _, s := range series {
+		doc += s
+	}
+	return doc + `
+</Publication_MarketDocument>`
+}
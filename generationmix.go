@@ -0,0 +1,203 @@
+package entsoe
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// generationTypes are the production types fetched by default, one request each.
+var generationTypes = []PsrType{
+	PsrTypeBiomass,
+	PsrTypeFossilBrownCoalLignite,
+	PsrTypeFossilCoalDerivedGas,
+	PsrTypeFossilGas,
+	PsrTypeFossilHardCoal,
+	PsrTypeFossilOil,
+	PsrTypeFossilOilShale,
+	PsrTypeFossilPeat,
+	PsrTypeGeothermal,
+	PsrTypeHydroPumpedStorage,
+	PsrTypeHydroRunOfRiverAndPoundage,
+	PsrTypeHydroWaterReservoir,
+	PsrTypeMarine,
+	PsrTypeNuclear,
+	PsrTypeOtherRenewable,
+	PsrTypeSolar,
+	PsrTypeWaste,
+	PsrTypeWindOffshore,
+	PsrTypeWindOnshore,
+	PsrTypeOther,
+}
+
+// GenerationMix fetches the actual generation per production type of an area.
+// It is safe for concurrent use.
+type GenerationMix struct {
+	client   *EntsoeClient
+	domain   DomainType
+	psrTypes []PsrType
+	allTypes bool
+}
+
+// GenerationMixOption configures a GenerationMix.
+type GenerationMixOption func(*GenerationMix)
+
+// WithPsrTypes restricts the production types fetched, every generation type by default.
+func WithPsrTypes(psrTypes ...PsrType) GenerationMixOption {
+	return func(g *GenerationMix) {
+		g.psrTypes = psrTypes
+	}
+}
+
+// WithAllTypes fetches every production type in a single request without psrType,
+// instead of a request per type. WithPsrTypes is then ignored.
+func WithAllTypes() GenerationMixOption {
+	return func(g *GenerationMix) {
+		g.allTypes = true
+	}
+}
+
+func NewGenerationMix(area Area, client *EntsoeClient, opts ...GenerationMixOption) (*GenerationMix, error) {
+	domain, err := domain(string(area))
+	if err != nil {
+		return nil, err
+	}
+
+	g := &GenerationMix{
+		client:   client,
+		domain:   domain,
+		psrTypes: generationTypes,
+	}
+	for _, opt := range opts {
+		opt(g)
+	}
+	return g, nil
+}
+
+// GenerationMixElement is the average generation of a 15-minute slot by production type.
+type GenerationMixElement struct {
+	Time time.Time
+	// Production_MW holds the generation of every type published for the slot.
+	Production_MW map[PsrType]float64
+	// Consumption_MW holds the consumption of the types which also consume,
+	// such as the pumping of pumped storage. It is nil when there is none.
+	Consumption_MW map[PsrType]float64
+}
+
+// GenerationMixResult is the outcome of a GenerationMix fetch.
+type GenerationMixResult struct {
+	// Mix holds the slots with at least one value, sorted by time.
+	Mix []GenerationMixElement
+	// Names holds the name of every production type of Mix, from GetPsrTypeName.
+	Names map[PsrType]string
+	// Errors holds the production types which failed. Types without data in
+	// the area are not errors, they are just missing from Mix.
+	Errors map[PsrType]error
+}
+
+// Fetch returns the generation mix of [from, to), at most one year: a longer window
+// fails with an error wrapping ErrInvalidParameters, without any request.
+// A failing type does not fail the others, see GenerationMixResult.Errors.
+// With WithAllTypes the error of the single request is returned instead.
+func (g *GenerationMix) Fetch(from, to time.Time) (*GenerationMixResult, error) {
+	return g.FetchContext(context.Background(), from, to)
+}
+
+// FetchContext is like Fetch but uses ctx for the requests.
+func (g *GenerationMix) FetchContext(ctx context.Context, from, to time.Time) (*GenerationMixResult, error) {
+	if err := checkWindow(from, to); err != nil {
+		return nil, err
+	}
+
+	res := &GenerationMixResult{
+		Names:  make(map[PsrType]string),
+		Errors: make(map[PsrType]error),
+	}
+
+	var series []Series
+	if g.allTypes {
+		s, err := g.fetch(ctx, "", from, to)
+		if err != nil {
+			return res, err
+		}
+		series = s
+	} else {
+		var mu sync.Mutex
+		var wg sync.WaitGroup
+		for _, psrType := range g.psrTypes {
+			wg.Add(1)
+			go func(psrType PsrType) {
+				defer wg.Done()
+
+				s, err := g.fetch(ctx, psrType, from, to)
+
+				mu.Lock()
+				defer mu.Unlock()
+				if err != nil {
+					logger.Error().Err(err).Str("psrType", string(psrType)).Msg("Error fetching generation")
+					res.Errors[psrType] = err
+					return
+				}
+				series = append(series, s...)
+			}(psrType)
+		}
+		wg.Wait()
+	}
+
+	production := make(map[int64]map[PsrType]float64)
+	consumption := make(map[int64]map[PsrType]float64)
+	for _, s := range series {
+		values, ok := s.slotValues(resolution15m)
+		if !ok {
+			logger.Warn().Str("resolution", string(s.Resolution)).Msg("unsupported resolution, skipping time series")
+			continue
+		}
+
+		target := production
+		if s.OutDomain != "" {
+			target = consumption
+		}
+		for k, v := range values {
+			if target[k] == nil {
+				target[k] = make(map[PsrType]float64)
+			}
+			target[k][s.PsrType] = v
+		}
+		res.Names[s.PsrType] = GetPsrTypeName(s.PsrType)
+	}
+
+	for _, slot := range slots(from, to) {
+		p, c := production[slot.Unix()], consumption[slot.Unix()]
+		if p == nil && c == nil {
+			continue
+		}
+		if p == nil {
+			p = make(map[PsrType]float64)
+		}
+		res.Mix = append(res.Mix, GenerationMixElement{
+			Time:           slot,
+			Production_MW:  p,
+			Consumption_MW: c,
+		})
+	}
+	return res, ctx.Err()
+}
+
+// FetchInterval is like FetchContext but takes the window as an Interval, such as a DeliveryDay.
+func (g *GenerationMix) FetchInterval(ctx context.Context, interval Interval) (*GenerationMixResult, error) {
+	return g.FetchContext(ctx, interval.Start, interval.End)
+}
+
+// fetch returns the realised generation series of a type, or of every type when psrType is empty.
+// A type without data in the area is no error.
+func (g *GenerationMix) fetch(ctx context.Context, psrType PsrType, from, to time.Time) ([]Series, error) {
+	doc, err := g.client.GetAggregatedGenerationPerTypeContext(ctx, ProcessTypeRealised, psrType, g.domain, from, to)
+	if errors.Is(err, ErrNoMatchingData) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return doc.Series()
+}
@@ -0,0 +1,129 @@
+package entsoe
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testPumpedStorageSeries = `
+	<TimeSeries>
+		<mRID>2</mRID>
+		<businessType>A01</businessType>
+		<inBiddingZone_Domain.mRID codingScheme="A01">10YFR-RTE------C</inBiddingZone_Domain.mRID>
+		<quantity_Measure_Unit.name>MAW</quantity_Measure_Unit.name>
+		<curveType>A01</curveType>
+		<MktPSRType>
+			<psrType>B10</psrType>
+		</MktPSRType>
+		<Period>
+			<timeInterval>
+				<start>2026-03-01T23:00Z</start>
+				<end>2026-03-02T00:00Z</end>
+			</timeInterval>
+			<resolution>PT60M</resolution>
+			<Point>
+				<position>1</position>
+				<quantity>300</quantity>
+			</Point>
+		</Period>
+	</TimeSeries>
+	<TimeSeries>
+		<mRID>3</mRID>
+		<businessType>A04</businessType>
+		<outBiddingZone_Domain.mRID codingScheme="A01">10YFR-RTE------C</outBiddingZone_Domain.mRID>
+		<quantity_Measure_Unit.name>MAW</quantity_Measure_Unit.name>
+		<curveType>A01</curveType>
+		<MktPSRType>
+			<psrType>B10</psrType>
+		</MktPSRType>
+		<Period>
+			<timeInterval>
+				<start>2026-03-01T23:00Z</start>
+				<end>2026-03-02T00:00Z</end>
+			</timeInterval>
+			<resolution>PT60M</resolution>
+			<Point>
+				<position>1</position>
+				<quantity>800</quantity>
+			</Point>
+		</Period>
+	</TimeSeries>
+</GL_MarketDocument>`
+
+// testGenerationMixDocument holds solar and pumped storage production and pumped storage consumption.
+var testGenerationMixDocument = strings.Replace(testGLMarketDocument, "\n</GL_MarketDocument>", testPumpedStorageSeries, 1)
+
+func TestGenerationMixAllTypes(t *testing.T) {
+	var psrTypes []string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		psrTypes = append(psrTypes, r.URL.Query()[ParameterPsrType]...)
+		w.Write([]byte(testGenerationMixDocument))
+	})
+
+	mix, err := NewGenerationMix(France, c, WithAllTypes())
+	assert.Nil(t, err)
+
+	res, err := mix.Fetch(genTime("202603012300"), genTime("202603020000"))
+	assert.Nil(t, err)
+	assert.Empty(t, psrTypes)
+	assert.Empty(t, res.Errors)
+	assert.Equal(t, map[PsrType]string{
+		PsrTypeSolar:              "Solar",
+		PsrTypeHydroPumpedStorage: "Hydro Pumped Storage",
+	}, res.Names)
+
+	if assert.Len(t, res.Mix, 4) {
+		assert.Equal(t, GenerationMixElement{
+			Time:           genTime("202603012300"),
+			Production_MW:  map[PsrType]float64{PsrTypeSolar: 10, PsrTypeHydroPumpedStorage: 300},
+			Consumption_MW: map[PsrType]float64{PsrTypeHydroPumpedStorage: 800},
+		}, res.Mix[0])
+		// position 3 is missing from the solar series
+		assert.Equal(t, map[PsrType]float64{PsrTypeHydroPumpedStorage: 300}, res.Mix[2].Production_MW)
+		assert.Equal(t, 16.5, res.Mix[3].Production_MW[PsrTypeSolar])
+	}
+}
+
+func TestGenerationMixPerType(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch PsrType(r.URL.Query().Get(ParameterPsrType)) {
+		case PsrTypeSolar:
+			w.Write([]byte(testGLMarketDocument))
+		case PsrTypeNuclear:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.Write([]byte(testAcknowledgement("No matching data found for Data item Aggregated Generation per Type [16.1.B&amp;C]")))
+		}
+	}, WithRetryPolicy(NoRetry), WithRateLimiter(nil))
+
+	mix, err := NewGenerationMix(France, c, WithPsrTypes(PsrTypeSolar, PsrTypeNuclear, PsrTypeMarine))
+	assert.Nil(t, err)
+
+	res, err := mix.Fetch(genTime("202603012300"), genTime("202603020000"))
+	assert.Nil(t, err)
+	assert.Len(t, res.Errors, 1)
+	assert.NotNil(t, res.Errors[PsrTypeNuclear])
+	assert.Equal(t, map[PsrType]string{PsrTypeSolar: "Solar"}, res.Names)
+	if assert.Len(t, res.Mix, 3) {
+		assert.Equal(t, 12.0, res.Mix[1].Production_MW[PsrTypeSolar])
+		assert.Nil(t, res.Mix[1].Consumption_MW)
+	}
+}
+
+func TestGenerationMixWindow(t *testing.T) {
+	requests := 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	}, WithRetryPolicy(NoRetry), WithRateLimiter(nil))
+
+	mix, err := NewGenerationMix(France, c)
+	assert.Nil(t, err)
+
+	_, err = mix.Fetch(genTime("202501010000"), genTime("202601010100"))
+	assert.True(t, errors.Is(err, ErrInvalidParameters), err)
+	assert.Zero(t, requests)
+}
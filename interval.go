@@ -0,0 +1,635 @@
+package entsoe
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Interval is the [Start, End) period of a request.
+type Interval struct {
+	Start time.Time
+	End   time.Time
+}
+
+// NewInterval returns the interval [start, end).
+func NewInterval(start, end time.Time) (Interval, error) {
+	if !start.Before(end) {
+		return Interval{}, fmt.Errorf("invalid interval: %s is not before %s", start, end)
+	}
+	return Interval{Start: start, End: end}, nil
+}
+
+// DeliveryDay returns the delivery day of area on the calendar date of date, in UTC.
+// The day starts at midnight in the market time zone of the area, see Area.Location,
+// and lasts 23 or 25 hours on DST changes.
+func DeliveryDay(area Area, date time.Time) (Interval, error) {
+	return DeliveryDays(area, date, date)
+}
+
+// DeliveryDays returns the delivery days of area from the calendar date of first
+// to the calendar date of last included, in UTC.
+func DeliveryDays(area Area, first, last time.Time) (Interval, error) {
+	loc, err := area.Location()
+	if err != nil {
+		return Interval{}, err
+	}
+
+	year, month, day := first.Date()
+	start := time.Date(year, month, day, 0, 0, 0, 0, loc)
+	year, month, day = last.Date()
+	end := time.Date(year, month, day+1, 0, 0, 0, 0, loc)
+	return NewInterval(start.UTC(), end.UTC())
+}
+
+// Duration returns the length of the interval.
+func (i Interval) Duration() time.Duration {
+	return i.End.Sub(i.Start)
+}
+
+// Contains reports whether t is within [Start, End).
+func (i Interval) Contains(t time.Time) bool {
+	return !t.Before(i.Start) && t.Before(i.End)
+}
+
+// checkWindow returns an error wrapping ErrInvalidParameters unless [from, to) is
+// a window of a single request: not empty and at most one year long.
+func checkWindow(from, to time.Time) error {
+	if !from.Before(to) {
+		return fmt.Errorf("%w: %s is not before %s", ErrInvalidParameters, from, to)
+	}
+	if to.After(from.AddDate(1, 0, 0)) {
+		return fmt.Errorf("%w: window from %s to %s is longer than one year", ErrInvalidParameters, from, to)
+	}
+	return nil
+}
+
+// Interval variants of every Get method taking a periodStart and a periodEnd.
+
+// GetActualTotalLoadInterval is like GetActualTotalLoadContext but takes the period as an Interval.
+func (c *EntsoeClient) GetActualTotalLoadInterval(
+	ctx context.Context,
+	domain DomainType,
+	interval Interval,
+) (*GLMarketDocument, error) {
+	return c.GetActualTotalLoadContext(ctx, domain, interval.Start, interval.End)
+}
+
+// GetDayAheadTotalLoadForecastInterval is like GetDayAheadTotalLoadForecastContext but takes the period as an Interval.
+func (c *EntsoeClient) GetDayAheadTotalLoadForecastInterval(
+	ctx context.Context,
+	domain DomainType,
+	interval Interval,
+) (*GLMarketDocument, error) {
+	return c.GetDayAheadTotalLoadForecastContext(ctx, domain, interval.Start, interval.End)
+}
+
+// GetWeekAheadTotalLoadForecastInterval is like GetWeekAheadTotalLoadForecastContext but takes the period as an Interval.
+func (c *EntsoeClient) GetWeekAheadTotalLoadForecastInterval(
+	ctx context.Context,
+	domain DomainType,
+	interval Interval,
+) (*GLMarketDocument, error) {
+	return c.GetWeekAheadTotalLoadForecastContext(ctx, domain, interval.Start, interval.End)
+}
+
+// GetMonthAheadTotalLoadForecastInterval is like GetMonthAheadTotalLoadForecastContext but takes the period as an Interval.
+func (c *EntsoeClient) GetMonthAheadTotalLoadForecastInterval(
+	ctx context.Context,
+	domain DomainType,
+	interval Interval,
+) (*GLMarketDocument, error) {
+	return c.GetMonthAheadTotalLoadForecastContext(ctx, domain, interval.Start, interval.End)
+}
+
+// GetYearAheadTotalLoadForecastInterval is like GetYearAheadTotalLoadForecastContext but takes the period as an Interval.
+func (c *EntsoeClient) GetYearAheadTotalLoadForecastInterval(
+	ctx context.Context,
+	domain DomainType,
+	interval Interval,
+) (*GLMarketDocument, error) {
+	return c.GetYearAheadTotalLoadForecastContext(ctx, domain, interval.Start, interval.End)
+}
+
+// GetYearAheadForecastMarginInterval is like GetYearAheadForecastMarginContext but takes the period as an Interval.
+func (c *EntsoeClient) GetYearAheadForecastMarginInterval(
+	ctx context.Context,
+	domain DomainType,
+	interval Interval,
+) (*GLMarketDocument, error) {
+	return c.GetYearAheadForecastMarginContext(ctx, domain, interval.Start, interval.End)
+}
+
+// GetExpansionAndDismantlingProjectsInterval is like GetExpansionAndDismantlingProjectsContext but takes the period as an Interval.
+func (c *EntsoeClient) GetExpansionAndDismantlingProjectsInterval(
+	ctx context.Context,
+	inDomain DomainType,
+	outDomain DomainType,
+	interval Interval,
+	business *BusinessType,
+	docStatus *DocStatus,
+) (*TransmissionNetworkMarketDocument, error) {
+	return c.GetExpansionAndDismantlingProjectsContext(ctx, inDomain, outDomain, interval.Start, interval.End, business, docStatus)
+}
+
+// GetForecastedCapacityInterval is like GetForecastedCapacityContext but takes the period as an Interval.
+func (c *EntsoeClient) GetForecastedCapacityInterval(
+	ctx context.Context,
+	contractMarketAgreement ContractMarketAgreementType,
+	inDomain DomainType,
+	outDomain DomainType,
+	interval Interval,
+) (*PublicationMarketDocument, error) {
+	return c.GetForecastedCapacityContext(ctx, contractMarketAgreement, inDomain, outDomain, interval.Start, interval.End)
+}
+
+// GetOfferedCapacityInterval is like GetOfferedCapacityContext but takes the period as an Interval.
+func (c *EntsoeClient) GetOfferedCapacityInterval(
+	ctx context.Context,
+	auctionType AuctionType,
+	contractMarketAgreement ContractMarketAgreementType,
+	inDomain DomainType,
+	outDomain DomainType,
+	interval Interval,
+	auctionCategory *AuctionCategory,
+	classificationSequenceAttributeInstanceComponentPosition *int,
+) (*PublicationMarketDocument, error) {
+	return c.GetOfferedCapacityContext(ctx, auctionType, contractMarketAgreement, inDomain, outDomain, interval.Start, interval.End, auctionCategory, classificationSequenceAttributeInstanceComponentPosition)
+}
+
+// GetFlowBasedParametersInterval is like GetFlowBasedParametersContext but takes the period as an Interval.
+func (c *EntsoeClient) GetFlowBasedParametersInterval(
+	ctx context.Context,
+	processType ProcessType,
+	domain DomainType,
+	interval Interval,
+) (*CriticalNetworkElementMarketDocument, error) {
+	return c.GetFlowBasedParametersContext(ctx, processType, domain, interval.Start, interval.End)
+}
+
+// GetIntradayTransferLimitsInterval is like GetIntradayTransferLimitsContext but takes the period as an Interval.
+func (c *EntsoeClient) GetIntradayTransferLimitsInterval(
+	ctx context.Context,
+	inDomain DomainType,
+	outDomain DomainType,
+	interval Interval,
+) (*PublicationMarketDocument, error) {
+	return c.GetIntradayTransferLimitsContext(ctx, inDomain, outDomain, interval.Start, interval.End)
+}
+
+// GetExplicitAllocationInformationInterval is like GetExplicitAllocationInformationContext but takes the period as an Interval.
+func (c *EntsoeClient) GetExplicitAllocationInformationInterval(
+	ctx context.Context,
+	businessType BusinessType,
+	contractMarketAgreementType ContractMarketAgreementType,
+	inDomain DomainType,
+	outDomain DomainType,
+	interval Interval,
+	auctionCategory *AuctionCategory,
+	classificationSequenceAttributeInstanceComponentPosition *int,
+) (*PublicationMarketDocument, error) {
+	return c.GetExplicitAllocationInformationContext(ctx, businessType, contractMarketAgreementType, inDomain, outDomain, interval.Start, interval.End, auctionCategory, classificationSequenceAttributeInstanceComponentPosition)
+}
+
+// GetTotalCapacityNominatedInterval is like GetTotalCapacityNominatedContext but takes the period as an Interval.
+func (c *EntsoeClient) GetTotalCapacityNominatedInterval(
+	ctx context.Context,
+	businessType BusinessType,
+	inDomain DomainType,
+	outDomain DomainType,
+	interval Interval,
+) (*PublicationMarketDocument, error) {
+	return c.GetTotalCapacityNominatedContext(ctx, businessType, inDomain, outDomain, interval.Start, interval.End)
+}
+
+// GetTotalCapacityAlreadyAllocatedInterval is like GetTotalCapacityAlreadyAllocatedContext but takes the period as an Interval.
+func (c *EntsoeClient) GetTotalCapacityAlreadyAllocatedInterval(
+	ctx context.Context,
+	businessType BusinessType,
+	contractMarketAgreementType ContractMarketAgreementType,
+	inDomain DomainType,
+	outDomain DomainType,
+	interval Interval,
+	auctionCategory *AuctionCategory,
+) (*PublicationMarketDocument, error) {
+	return c.GetTotalCapacityAlreadyAllocatedContext(ctx, businessType, contractMarketAgreementType, inDomain, outDomain, interval.Start, interval.End, auctionCategory)
+}
+
+// GetDayAheadPricesInterval is like GetDayAheadPricesContext but takes the period as an Interval.
+func (c *EntsoeClient) GetDayAheadPricesInterval(
+	ctx context.Context,
+	domain DomainType,
+	interval Interval,
+) (*PublicationMarketDocument, error) {
+	return c.GetDayAheadPricesContext(ctx, domain, interval.Start, interval.End)
+}
+
+// GetImplicitAuctionInterval is like GetImplicitAuctionContext but takes the period as an Interval.
+func (c *EntsoeClient) GetImplicitAuctionInterval(
+	ctx context.Context,
+	businessType BusinessType,
+	contractMarketAgreementType ContractMarketAgreementType,
+	domain DomainType,
+	interval Interval,
+) (*PublicationMarketDocument, error) {
+	return c.GetImplicitAuctionContext(ctx, businessType, contractMarketAgreementType, domain, interval.Start, interval.End)
+}
+
+// GetTotalCommercialSchedulesInterval is like GetTotalCommercialSchedulesContext but takes the period as an Interval.
+func (c *EntsoeClient) GetTotalCommercialSchedulesInterval(
+	ctx context.Context,
+	inDomain DomainType,
+	outDomain DomainType,
+	interval Interval,
+	contractType *ContractMarketAgreementType,
+) (*PublicationMarketDocument, error) {
+	return c.GetTotalCommercialSchedulesContext(ctx, inDomain, outDomain, interval.Start, interval.End, contractType)
+}
+
+// GetDayAheadCommercialSchedulesInterval is like GetDayAheadCommercialSchedulesContext but takes the period as an Interval.
+func (c *EntsoeClient) GetDayAheadCommercialSchedulesInterval(
+	ctx context.Context,
+	inDomain DomainType,
+	outDomain DomainType,
+	interval Interval,
+	contractType *ContractMarketAgreementType,
+) (*PublicationMarketDocument, error) {
+	return c.GetDayAheadCommercialSchedulesContext(ctx, inDomain, outDomain, interval.Start, interval.End, contractType)
+}
+
+// GetPhysicalFlowsInterval is like GetPhysicalFlowsContext but takes the period as an Interval.
+func (c *EntsoeClient) GetPhysicalFlowsInterval(
+	ctx context.Context,
+	inDomain DomainType,
+	outDomain DomainType,
+	interval Interval,
+) (*PublicationMarketDocument, error) {
+	return c.GetPhysicalFlowsContext(ctx, inDomain, outDomain, interval.Start, interval.End)
+}
+
+// GetCapacityAllocatedOutsideEuInterval is like GetCapacityAllocatedOutsideEuContext but takes the period as an Interval.
+func (c *EntsoeClient) GetCapacityAllocatedOutsideEuInterval(
+	ctx context.Context,
+	auctionType AuctionType,
+	contractMarketAgreementType ContractMarketAgreementType,
+	inDomain DomainType,
+	outDomain DomainType,
+	interval Interval,
+	auctionCategory *AuctionCategory,
+	classificationSequenceAttributeInstanceComponentPosition *int,
+) (*PublicationMarketDocument, error) {
+	return c.GetCapacityAllocatedOutsideEuContext(ctx, auctionType, contractMarketAgreementType, inDomain, outDomain, interval.Start, interval.End, auctionCategory, classificationSequenceAttributeInstanceComponentPosition)
+}
+
+// GetRedispatchingInterval is like GetRedispatchingContext but takes the period as an Interval.
+func (c *EntsoeClient) GetRedispatchingInterval(
+	ctx context.Context,
+	inDomain DomainType,
+	outDomain DomainType,
+	interval Interval,
+	business *BusinessType,
+) (*TransmissionNetworkMarketDocument, error) {
+	return c.GetRedispatchingContext(ctx, inDomain, outDomain, interval.Start, interval.End, business)
+}
+
+// GetCountertradingInterval is like GetCountertradingContext but takes the period as an Interval.
+func (c *EntsoeClient) GetCountertradingInterval(
+	ctx context.Context,
+	inDomain DomainType,
+	outDomain DomainType,
+	interval Interval,
+) (*TransmissionNetworkMarketDocument, error) {
+	return c.GetCountertradingContext(ctx, inDomain, outDomain, interval.Start, interval.End)
+}
+
+// GetCostsOfCongestionManagementInterval is like GetCostsOfCongestionManagementContext but takes the period as an Interval.
+func (c *EntsoeClient) GetCostsOfCongestionManagementInterval(
+	ctx context.Context,
+	domain DomainType,
+	interval Interval,
+	business *BusinessType,
+) (*TransmissionNetworkMarketDocument, error) {
+	return c.GetCostsOfCongestionManagementContext(ctx, domain, interval.Start, interval.End, business)
+}
+
+// GetInstalledGenerationCapacityAggregatedInterval is like GetInstalledGenerationCapacityAggregatedContext but takes the period as an Interval.
+func (c *EntsoeClient) GetInstalledGenerationCapacityAggregatedInterval(
+	ctx context.Context,
+	processType ProcessType,
+	inDomain DomainType,
+	interval Interval,
+	psrType *PsrType,
+) (*GLMarketDocument, error) {
+	return c.GetInstalledGenerationCapacityAggregatedContext(ctx, processType, inDomain, interval.Start, interval.End, psrType)
+}
+
+// GetInstalledGenerationCapacityPerUnitInterval is like GetInstalledGenerationCapacityPerUnitContext but takes the period as an Interval.
+func (c *EntsoeClient) GetInstalledGenerationCapacityPerUnitInterval(
+	ctx context.Context,
+	processType ProcessType,
+	inDomain DomainType,
+	interval Interval,
+	psrType *PsrType,
+) (*GLMarketDocument, error) {
+	return c.GetInstalledGenerationCapacityPerUnitContext(ctx, processType, inDomain, interval.Start, interval.End, psrType)
+}
+
+// GetDayAheadAggregatedGenerationInterval is like GetDayAheadAggregatedGenerationContext but takes the period as an Interval.
+func (c *EntsoeClient) GetDayAheadAggregatedGenerationInterval(
+	ctx context.Context,
+	processType ProcessType,
+	inDomain DomainType,
+	interval Interval,
+) (*GLMarketDocument, error) {
+	return c.GetDayAheadAggregatedGenerationContext(ctx, processType, inDomain, interval.Start, interval.End)
+}
+
+// GetGenerationForecastsForWindAndSolarInterval is like GetGenerationForecastsForWindAndSolarContext but takes the period as an Interval.
+func (c *EntsoeClient) GetGenerationForecastsForWindAndSolarInterval(
+	ctx context.Context,
+	processType ProcessType,
+	inDomain DomainType,
+	interval Interval,
+	psrType *PsrType,
+) (*GLMarketDocument, error) {
+	return c.GetGenerationForecastsForWindAndSolarContext(ctx, processType, inDomain, interval.Start, interval.End, psrType)
+}
+
+// GetActualGenerationOutputPerGenerationUnitInterval is like GetActualGenerationOutputPerGenerationUnitContext but takes the period as an Interval.
+func (c *EntsoeClient) GetActualGenerationOutputPerGenerationUnitInterval(
+	ctx context.Context,
+	processType ProcessType,
+	inDomain DomainType,
+	interval Interval,
+	psrType *PsrType,
+	registeredResource *string,
+) (*GLMarketDocument, error) {
+	return c.GetActualGenerationOutputPerGenerationUnitContext(ctx, processType, inDomain, interval.Start, interval.End, psrType, registeredResource)
+}
+
+// GetAggregatedGenerationPerTypeInterval is like GetAggregatedGenerationPerTypeContext but takes the period as an Interval.
+func (c *EntsoeClient) GetAggregatedGenerationPerTypeInterval(
+	ctx context.Context,
+	processType ProcessType,
+	psrType PsrType,
+	inDomain DomainType,
+	interval Interval,
+) (*GLMarketDocument, error) {
+	return c.GetAggregatedGenerationPerTypeContext(ctx, processType, psrType, inDomain, interval.Start, interval.End)
+}
+
+// GetAggregatedFillingRateOfWaterReservoirsAndHydroStoragePlantsInterval is like GetAggregatedFillingRateOfWaterReservoirsAndHydroStoragePlantsContext but takes the period as an Interval.
+func (c *EntsoeClient) GetAggregatedFillingRateOfWaterReservoirsAndHydroStoragePlantsInterval(
+	ctx context.Context,
+	processType ProcessType,
+	inDomain DomainType,
+	interval Interval,
+) (*GLMarketDocument, error) {
+	return c.GetAggregatedFillingRateOfWaterReservoirsAndHydroStoragePlantsContext(ctx, processType, inDomain, interval.Start, interval.End)
+}
+
+// GetCurrentBalancingStateInterval is like GetCurrentBalancingStateContext but takes the period as an Interval.
+func (c *EntsoeClient) GetCurrentBalancingStateInterval(
+	ctx context.Context,
+	area DomainType,
+	interval Interval,
+) (*BalancingMarketDocument, error) {
+	return c.GetCurrentBalancingStateContext(ctx, area, interval.Start, interval.End)
+}
+
+// GetAggregatedBalancingEnergyBidsInterval is like GetAggregatedBalancingEnergyBidsContext but takes the period as an Interval.
+func (c *EntsoeClient) GetAggregatedBalancingEnergyBidsInterval(
+	ctx context.Context,
+	processType ProcessType,
+	area DomainType,
+	interval Interval,
+) (*BalancingMarketDocument, error) {
+	return c.GetAggregatedBalancingEnergyBidsContext(ctx, processType, area, interval.Start, interval.End)
+}
+
+// GetActivatedBalancingEnergyPricesByProcessInterval is like GetActivatedBalancingEnergyPricesByProcessContext but takes the period as an Interval.
+func (c *EntsoeClient) GetActivatedBalancingEnergyPricesByProcessInterval(
+	ctx context.Context,
+	processType ProcessType,
+	area DomainType,
+	interval Interval,
+) (*BalancingMarketDocument, error) {
+	return c.GetActivatedBalancingEnergyPricesByProcessContext(ctx, processType, area, interval.Start, interval.End)
+}
+
+// GetUseOfAllocatedCrossZonalBalancingCapacityInterval is like GetUseOfAllocatedCrossZonalBalancingCapacityContext but takes the period as an Interval.
+func (c *EntsoeClient) GetUseOfAllocatedCrossZonalBalancingCapacityInterval(
+	ctx context.Context,
+	processType ProcessType,
+	acquiringDomain DomainType,
+	connectingDomain DomainType,
+	interval Interval,
+) (*BalancingMarketDocument, error) {
+	return c.GetUseOfAllocatedCrossZonalBalancingCapacityContext(ctx, processType, acquiringDomain, connectingDomain, interval.Start, interval.End)
+}
+
+// GetAmountOfBalancingReservesUnderContractInterval is like GetAmountOfBalancingReservesUnderContractContext but takes the period as an Interval.
+func (c *EntsoeClient) GetAmountOfBalancingReservesUnderContractInterval(
+	ctx context.Context,
+	typeMarketAgreement ContractMarketAgreementType,
+	businessType BusinessType,
+	controlArea DomainType,
+	interval Interval,
+	psrType *PsrType,
+) (*BalancingMarketDocument, error) {
+	return c.GetAmountOfBalancingReservesUnderContractContext(ctx, typeMarketAgreement, businessType, controlArea, interval.Start, interval.End, psrType)
+}
+
+// GetPricesOfProcuredBalancingReservesInterval is like GetPricesOfProcuredBalancingReservesContext but takes the period as an Interval.
+func (c *EntsoeClient) GetPricesOfProcuredBalancingReservesInterval(
+	ctx context.Context,
+	typeMarketAgreement ContractMarketAgreementType,
+	controlArea DomainType,
+	interval Interval,
+	businessType *BusinessType,
+) (*BalancingMarketDocument, error) {
+	return c.GetPricesOfProcuredBalancingReservesContext(ctx, typeMarketAgreement, controlArea, interval.Start, interval.End, businessType)
+}
+
+// GetAcceptedAggregatedOffersInterval is like GetAcceptedAggregatedOffersContext but takes the period as an Interval.
+func (c *EntsoeClient) GetAcceptedAggregatedOffersInterval(
+	ctx context.Context,
+	controlArea DomainType,
+	interval Interval,
+	businessType *BusinessType,
+) (*BalancingMarketDocument, error) {
+	return c.GetAcceptedAggregatedOffersContext(ctx, controlArea, interval.Start, interval.End, businessType)
+}
+
+// GetActivatedBalancingEnergyInterval is like GetActivatedBalancingEnergyContext but takes the period as an Interval.
+func (c *EntsoeClient) GetActivatedBalancingEnergyInterval(
+	ctx context.Context,
+	controlArea DomainType,
+	interval Interval,
+	businessType *BusinessType,
+) (*BalancingMarketDocument, error) {
+	return c.GetActivatedBalancingEnergyContext(ctx, controlArea, interval.Start, interval.End, businessType)
+}
+
+// GetActivatedBalancingEnergyPricesInterval is like GetActivatedBalancingEnergyPricesContext but takes the period as an Interval.
+func (c *EntsoeClient) GetActivatedBalancingEnergyPricesInterval(
+	ctx context.Context,
+	controlArea DomainType,
+	interval Interval,
+	businessType *BusinessType,
+) (*BalancingMarketDocument, error) {
+	return c.GetActivatedBalancingEnergyPricesContext(ctx, controlArea, interval.Start, interval.End, businessType)
+}
+
+// GetImbalancePricesInterval is like GetImbalancePricesContext but takes the period as an Interval.
+func (c *EntsoeClient) GetImbalancePricesInterval(
+	ctx context.Context,
+	controlArea DomainType,
+	interval Interval,
+) (*BalancingMarketDocument, error) {
+	return c.GetImbalancePricesContext(ctx, controlArea, interval.Start, interval.End)
+}
+
+// GetTotalImbalanceVolumesInterval is like GetTotalImbalanceVolumesContext but takes the period as an Interval.
+func (c *EntsoeClient) GetTotalImbalanceVolumesInterval(
+	ctx context.Context,
+	controlArea DomainType,
+	interval Interval,
+) (*BalancingMarketDocument, error) {
+	return c.GetTotalImbalanceVolumesContext(ctx, controlArea, interval.Start, interval.End)
+}
+
+// GetFinancialExpensesAndIncomeForBalancingInterval is like GetFinancialExpensesAndIncomeForBalancingContext but takes the period as an Interval.
+func (c *EntsoeClient) GetFinancialExpensesAndIncomeForBalancingInterval(
+	ctx context.Context,
+	controlArea DomainType,
+	interval Interval,
+) (*BalancingMarketDocument, error) {
+	return c.GetFinancialExpensesAndIncomeForBalancingContext(ctx, controlArea, interval.Start, interval.End)
+}
+
+// GetCrossBorderBalancingInterval is like GetCrossBorderBalancingContext but takes the period as an Interval.
+func (c *EntsoeClient) GetCrossBorderBalancingInterval(
+	ctx context.Context,
+	acquiringDomain DomainType,
+	connectingDomain DomainType,
+	interval Interval,
+) (*BalancingMarketDocument, error) {
+	return c.GetCrossBorderBalancingContext(ctx, acquiringDomain, connectingDomain, interval.Start, interval.End)
+}
+
+// GetFCRTotalCapacityInterval is like GetFCRTotalCapacityContext but takes the period as an Interval.
+func (c *EntsoeClient) GetFCRTotalCapacityInterval(
+	ctx context.Context,
+	area DomainType,
+	interval Interval,
+) (*BalancingMarketDocument, error) {
+	return c.GetFCRTotalCapacityContext(ctx, area, interval.Start, interval.End)
+}
+
+// GetSharesOfFCRCapacityInterval is like GetSharesOfFCRCapacityContext but takes the period as an Interval.
+func (c *EntsoeClient) GetSharesOfFCRCapacityInterval(
+	ctx context.Context,
+	area DomainType,
+	interval Interval,
+) (*BalancingMarketDocument, error) {
+	return c.GetSharesOfFCRCapacityContext(ctx, area, interval.Start, interval.End)
+}
+
+// GetContractedFCRReserveCapacityInterval is like GetContractedFCRReserveCapacityContext but takes the period as an Interval.
+func (c *EntsoeClient) GetContractedFCRReserveCapacityInterval(
+	ctx context.Context,
+	area DomainType,
+	interval Interval,
+) (*BalancingMarketDocument, error) {
+	return c.GetContractedFCRReserveCapacityContext(ctx, area, interval.Start, interval.End)
+}
+
+// GetFRRActualCapacityInterval is like GetFRRActualCapacityContext but takes the period as an Interval.
+func (c *EntsoeClient) GetFRRActualCapacityInterval(
+	ctx context.Context,
+	area DomainType,
+	interval Interval,
+) (*BalancingMarketDocument, error) {
+	return c.GetFRRActualCapacityContext(ctx, area, interval.Start, interval.End)
+}
+
+// GetRRActualCapacityInterval is like GetRRActualCapacityContext but takes the period as an Interval.
+func (c *EntsoeClient) GetRRActualCapacityInterval(
+	ctx context.Context,
+	area DomainType,
+	interval Interval,
+) (*BalancingMarketDocument, error) {
+	return c.GetRRActualCapacityContext(ctx, area, interval.Start, interval.End)
+}
+
+// GetSharingOfRRAndFRRInterval is like GetSharingOfRRAndFRRContext but takes the period as an Interval.
+func (c *EntsoeClient) GetSharingOfRRAndFRRInterval(
+	ctx context.Context,
+	processType ProcessType,
+	acquiringDomain DomainType,
+	connectingDomain DomainType,
+	interval Interval,
+) (*BalancingMarketDocument, error) {
+	return c.GetSharingOfRRAndFRRContext(ctx, processType, acquiringDomain, connectingDomain, interval.Start, interval.End)
+}
+
+// GetUnavailabilityOfConsumptionUnitsInterval is like GetUnavailabilityOfConsumptionUnitsContext but takes the period as an Interval.
+func (c *EntsoeClient) GetUnavailabilityOfConsumptionUnitsInterval(
+	ctx context.Context,
+	biddingZone DomainType,
+	interval Interval,
+	filter *UnavailabilityFilter,
+) ([]*UnavailabilityMarketDocument, error) {
+	return c.GetUnavailabilityOfConsumptionUnitsContext(ctx, biddingZone, interval.Start, interval.End, filter)
+}
+
+// GetUnavailabilityOfTransmissionInfrastructureInterval is like GetUnavailabilityOfTransmissionInfrastructureContext but takes the period as an Interval.
+func (c *EntsoeClient) GetUnavailabilityOfTransmissionInfrastructureInterval(
+	ctx context.Context,
+	inDomain DomainType,
+	outDomain DomainType,
+	interval Interval,
+	filter *UnavailabilityFilter,
+) ([]*UnavailabilityMarketDocument, error) {
+	return c.GetUnavailabilityOfTransmissionInfrastructureContext(ctx, inDomain, outDomain, interval.Start, interval.End, filter)
+}
+
+// GetUnavailabilityOfOffshoreGridInfrastructureInterval is like GetUnavailabilityOfOffshoreGridInfrastructureContext but takes the period as an Interval.
+func (c *EntsoeClient) GetUnavailabilityOfOffshoreGridInfrastructureInterval(
+	ctx context.Context,
+	biddingZone DomainType,
+	interval Interval,
+	filter *UnavailabilityFilter,
+) ([]*UnavailabilityMarketDocument, error) {
+	return c.GetUnavailabilityOfOffshoreGridInfrastructureContext(ctx, biddingZone, interval.Start, interval.End, filter)
+}
+
+// GetUnavailabilityOfGenerationUnitsInterval is like GetUnavailabilityOfGenerationUnitsContext but takes the period as an Interval.
+func (c *EntsoeClient) GetUnavailabilityOfGenerationUnitsInterval(
+	ctx context.Context,
+	biddingZone DomainType,
+	interval Interval,
+	filter *UnavailabilityFilter,
+) ([]*UnavailabilityMarketDocument, error) {
+	return c.GetUnavailabilityOfGenerationUnitsContext(ctx, biddingZone, interval.Start, interval.End, filter)
+}
+
+// GetUnavailabilityOfProductionUnitsInterval is like GetUnavailabilityOfProductionUnitsContext but takes the period as an Interval.
+func (c *EntsoeClient) GetUnavailabilityOfProductionUnitsInterval(
+	ctx context.Context,
+	biddingZone DomainType,
+	interval Interval,
+	filter *UnavailabilityFilter,
+) ([]*UnavailabilityMarketDocument, error) {
+	return c.GetUnavailabilityOfProductionUnitsContext(ctx, biddingZone, interval.Start, interval.End, filter)
+}
+
+// GetPhysicalFlowsOfAreaInterval is like GetPhysicalFlowsOfAreaContext but takes the period as an Interval.
+func (c *EntsoeClient) GetPhysicalFlowsOfAreaInterval(
+	ctx context.Context,
+	area Area,
+	interval Interval,
+) (*BorderDocuments, error) {
+	return c.GetPhysicalFlowsOfAreaContext(ctx, area, interval.Start, interval.End)
+}
@@ -0,0 +1,82 @@
+package entsoe
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// skipWithoutTZData skips the test when the time zone database cannot be loaded.
+func skipWithoutTZData(t *testing.T) {
+	if _, err := time.LoadLocation("Europe/Paris"); err != nil {
+		t.Skip(err)
+	}
+}
+
+func TestDeliveryDay(t *testing.T) {
+	tests := []struct {
+		area       Area
+		date       time.Time
+		start, end string
+		hours      time.Duration
+	}{
+		{France, time.Date(2025, 3, 30, 0, 0, 0, 0, time.UTC), "202503292300", "202503302200", 23},
+		{France, time.Date(2025, 10, 26, 12, 0, 0, 0, time.UTC), "202510252200", "202510262300", 25},
+		{Germany, time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC), "202505312200", "202506012200", 24},
+		{Finland, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), "202412312300", "202501012300", 24},
+		// the SEM trading day runs from 23:00 to 23:00 Irish time
+		{IrelandSEM, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), "202412312300", "202501012300", 24},
+		{IrelandSEM, time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC), "202505312200", "202506012200", 24},
+	}
+
+	skipWithoutTZData(t)
+	for _, tt := range tests {
+		interval, err := DeliveryDay(tt.area, tt.date)
+		if !assert.Nil(t, err, tt.area) {
+			continue
+		}
+		assert.Equal(t, genTime(tt.start), interval.Start, tt.area)
+		assert.Equal(t, genTime(tt.end), interval.End, tt.area)
+		assert.Equal(t, tt.hours*time.Hour, interval.Duration(), tt.area)
+	}
+
+	_, err := DeliveryDay("XX", time.Now())
+	assert.NotNil(t, err)
+}
+
+func TestDeliveryDays(t *testing.T) {
+	skipWithoutTZData(t)
+	interval, err := DeliveryDays(France, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, genTime("202502282300"), interval.Start)
+	assert.Equal(t, genTime("202503312200"), interval.End)
+	assert.True(t, interval.Contains(genTime("202502282300")))
+	assert.False(t, interval.Contains(genTime("202503312200")))
+
+	_, err = DeliveryDays(France, time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC), time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC))
+	assert.NotNil(t, err)
+}
+
+func TestGetInterval(t *testing.T) {
+	var query url.Values
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Write([]byte(testPublicationMarketDocument))
+	})
+
+	skipWithoutTZData(t)
+	interval, err := DeliveryDay(France, time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = c.GetDayAheadPricesInterval(context.Background(), DomainFR, interval)
+	assert.Nil(t, err)
+	assert.Equal(t, "202603012300", query.Get(ParameterPeriodStart))
+	assert.Equal(t, "202603022300", query.Get(ParameterPeriodEnd))
+}
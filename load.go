@@ -0,0 +1,212 @@
+package entsoe
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"time"
+)
+
+// loadHorizons are the load forecasts fetched by Load, by process type.
+var loadHorizons = []ProcessType{
+	ProcessTypeDayAhead,
+	ProcessTypeWeekAhead,
+	ProcessTypeMonthAhead,
+	ProcessTypeYearAhead,
+}
+
+// loadFunc is one of the total load methods of the client.
+type loadFunc func(context.Context, DomainType, time.Time, time.Time) (*GLMarketDocument, error)
+
+// Load fetches the actual total load of an area and its forecasts.
+// It is safe for concurrent use.
+type Load struct {
+	client *EntsoeClient
+	domain DomainType
+}
+
+// LoadResult holds the actual load and the forecasts of every horizon on a common 15-minute grid.
+type LoadResult struct {
+	// Times are the 15-minute slots of the window, sorted.
+	Times []time.Time
+	// Actual is the actual load, aligned with Times. Missing values are NaN.
+	Actual []float64
+	// Forecasts holds the forecast of every horizon by process type, aligned with Times.
+	// Missing values are NaN. Horizons published as a minimum and a maximum, such as
+	// the daily values of the week-ahead forecast, are given as their midpoint.
+	Forecasts map[ProcessType][]float64
+	// Metrics holds the errors of every horizon against Actual.
+	Metrics map[ProcessType]ForecastMetrics
+	// Errors holds the requests which failed, by process type, ProcessTypeRealised for the actual load.
+	Errors map[ProcessType]error
+}
+
+// ForecastMetrics compares a forecast with the actual values over the slots where both are known.
+type ForecastMetrics struct {
+	// Count is the number of slots compared. The metrics are NaN when it is 0.
+	Count int
+	// MAE is the mean absolute error, in MW.
+	MAE float64
+	// MAPE is the mean absolute percentage error, in percent. Slots with an actual of 0 are left out.
+	MAPE float64
+	// Bias is the mean of forecast - actual, in MW, positive when the forecast is too high.
+	Bias float64
+}
+
+func NewLoad(area Area, client *EntsoeClient) (*Load, error) {
+	domain, err := domain(string(area))
+	if err != nil {
+		return nil, err
+	}
+	return &Load{
+		client: client,
+		domain: domain,
+	}, nil
+}
+
+// Fetch returns the load of [from, to), at most one year: a longer window fails
+// with an error wrapping ErrInvalidParameters, without any request.
+// A failing request does not fail the others, see LoadResult.Errors.
+func (l *Load) Fetch(from, to time.Time) (*LoadResult, error) {
+	return l.FetchContext(context.Background(), from, to)
+}
+
+// FetchContext is like Fetch but uses ctx for the requests.
+// Past the window check, the only error returned is ctx.Err(), along with what was fetched so far.
+func (l *Load) FetchContext(ctx context.Context, from, to time.Time) (*LoadResult, error) {
+	if err := checkWindow(from, to); err != nil {
+		return nil, err
+	}
+
+	res := &LoadResult{
+		Times:     slots(from, to),
+		Forecasts: make(map[ProcessType][]float64, len(loadHorizons)),
+		Metrics:   make(map[ProcessType]ForecastMetrics, len(loadHorizons)),
+		Errors:    make(map[ProcessType]error),
+	}
+
+	fetches := map[ProcessType]loadFunc{
+		ProcessTypeRealised:   l.client.GetActualTotalLoadContext,
+		ProcessTypeDayAhead:   l.client.GetDayAheadTotalLoadForecastContext,
+		ProcessTypeWeekAhead:  l.client.GetWeekAheadTotalLoadForecastContext,
+		ProcessTypeMonthAhead: l.client.GetMonthAheadTotalLoadForecastContext,
+		ProcessTypeYearAhead:  l.client.GetYearAheadTotalLoadForecastContext,
+	}
+
+	rows := make(map[ProcessType][]float64, len(fetches))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for processType, fetch := range fetches {
+		wg.Add(1)
+		go func(processType ProcessType, fetch loadFunc) {
+			defer wg.Done()
+
+			row, err := l.fetch(ctx, fetch, res.Times, from, to)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				logger.Error().Err(err).Str("processType", string(processType)).Msg("Error fetching load")
+				res.Errors[processType] = err
+			}
+			rows[processType] = row
+		}(processType, fetch)
+	}
+	wg.Wait()
+
+	res.Actual = rows[ProcessTypeRealised]
+	for _, processType := range loadHorizons {
+		res.Forecasts[processType] = rows[processType]
+		res.Metrics[processType] = CompareForecast(rows[processType], res.Actual)
+	}
+
+	return res, ctx.Err()
+}
+
+// FetchInterval is like FetchContext but takes the window as an Interval, such as a DeliveryDay.
+func (l *Load) FetchInterval(ctx context.Context, interval Interval) (*LoadResult, error) {
+	return l.FetchContext(ctx, interval.Start, interval.End)
+}
+
+// fetch returns the load of a document aligned with times, NaN where missing.
+// A document without data is no error, its row is all NaN.
+func (l *Load) fetch(ctx context.Context, fetch loadFunc, times []time.Time, from, to time.Time) ([]float64, error) {
+	row := make([]float64, len(times))
+	for i := range row {
+		row[i] = math.NaN()
+	}
+
+	doc, err := fetch(ctx, l.domain, from, to)
+	if errors.Is(err, ErrNoMatchingData) {
+		return row, nil
+	}
+	if err != nil {
+		return row, err
+	}
+
+	series, err := doc.Series()
+	if err != nil {
+		return row, err
+	}
+
+	// minimum and maximum forecasts come as series of different business types
+	sums := make(map[int64]float64)
+	seen := make(map[int64]map[BusinessType]bool)
+	for _, s := range series {
+		values, ok := s.slotValues(resolution15m)
+		if !ok {
+			logger.Warn().Str("resolution", string(s.Resolution)).Msg("unsupported resolution, skipping time series")
+			continue
+		}
+		for k, v := range values {
+			if seen[k] == nil {
+				seen[k] = make(map[BusinessType]bool)
+			}
+			if seen[k][s.BusinessType] {
+				continue
+			}
+			seen[k][s.BusinessType] = true
+			sums[k] += v
+		}
+	}
+
+	for i, t := range times {
+		if n := len(seen[t.Unix()]); n > 0 {
+			row[i] = sums[t.Unix()] / float64(n)
+		}
+	}
+	return row, nil
+}
+
+// CompareForecast computes the errors of forecast against actual, two aligned series
+// where missing values are NaN.
+func CompareForecast(forecast, actual []float64) ForecastMetrics {
+	var m ForecastMetrics
+	var absErr, pctErr, bias float64
+	pctCount := 0
+	for i := range forecast {
+		if i >= len(actual) || math.IsNaN(forecast[i]) || math.IsNaN(actual[i]) {
+			continue
+		}
+		diff := forecast[i] - actual[i]
+		m.Count++
+		absErr += math.Abs(diff)
+		bias += diff
+		if actual[i] != 0 {
+			pctErr += math.Abs(diff / actual[i])
+			pctCount++
+		}
+	}
+
+	if m.Count == 0 {
+		return ForecastMetrics{MAE: math.NaN(), MAPE: math.NaN(), Bias: math.NaN()}
+	}
+	m.MAE = absErr / float64(m.Count)
+	m.Bias = bias / float64(m.Count)
+	m.MAPE = math.NaN()
+	if pctCount > 0 {
+		m.MAPE = 100 * pctErr / float64(pctCount)
+	}
+	return m
+}
@@ -0,0 +1,85 @@
+package entsoe
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testLoadEnd ends the day of the load series.
+const testLoadEnd = "2026-03-02T23:00Z"
+
+func TestLoad(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch ProcessType(r.URL.Query().Get(ParameterProcessType)) {
+		case ProcessTypeRealised:
+			w.Write([]byte(testGLDocument(DocumentTypeSystemTotalLoad, "", testSeries("A04", "", testLoadEnd, ResolutionQuarter, 1000, 1100, 1200, 1300))))
+		case ProcessTypeDayAhead:
+			w.Write([]byte(testGLDocument(DocumentTypeSystemTotalLoad, "", testSeries("A04", "", testLoadEnd, ResolutionHour, 1150))))
+		case ProcessTypeWeekAhead:
+			w.Write([]byte(testGLDocument(DocumentTypeSystemTotalLoad, "",
+				testSeries("A60", "", testLoadEnd, ResolutionDay, 900),
+				testSeries("A61", "", testLoadEnd, ResolutionDay, 1300),
+			)))
+		case ProcessTypeMonthAhead:
+			w.Write([]byte(testAcknowledgement("No matching data found for Data item Month-ahead Total Load Forecast [6.1.D]")))
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}, WithRetryPolicy(NoRetry), WithRateLimiter(nil))
+
+	load, err := NewLoad(France, c)
+	assert.Nil(t, err)
+
+	res, err := load.Fetch(genTime("202603012300"), genTime("202603020000"))
+	assert.Nil(t, err)
+
+	assert.Len(t, res.Times, 4)
+	assert.Equal(t, []float64{1000, 1100, 1200, 1300}, res.Actual)
+	assert.Equal(t, []float64{1150, 1150, 1150, 1150}, res.Forecasts[ProcessTypeDayAhead])
+	assert.Equal(t, []float64{1100, 1100, 1100, 1100}, res.Forecasts[ProcessTypeWeekAhead])
+	assert.True(t, math.IsNaN(res.Forecasts[ProcessTypeMonthAhead][0]))
+
+	assert.Len(t, res.Errors, 1)
+	assert.NotNil(t, res.Errors[ProcessTypeYearAhead])
+
+	dayAhead := res.Metrics[ProcessTypeDayAhead]
+	assert.Equal(t, 4, dayAhead.Count)
+	assert.Equal(t, 100.0, dayAhead.MAE)
+	assert.Equal(t, 0.0, dayAhead.Bias)
+	assert.InDelta(t, 100*(150.0/1000+50.0/1100+50.0/1200+150.0/1300)/4, dayAhead.MAPE, 1e-9)
+
+	weekAhead := res.Metrics[ProcessTypeWeekAhead]
+	assert.Equal(t, 100.0, weekAhead.MAE)
+	assert.Equal(t, -50.0, weekAhead.Bias)
+
+	monthAhead := res.Metrics[ProcessTypeMonthAhead]
+	assert.Equal(t, 0, monthAhead.Count)
+	assert.True(t, math.IsNaN(monthAhead.MAE))
+}
+
+func TestCompareForecast(t *testing.T) {
+	nan := math.NaN()
+	m := CompareForecast([]float64{10, nan, 30, 5}, []float64{20, 10, nan, 0})
+	assert.Equal(t, 2, m.Count)
+	assert.Equal(t, 7.5, m.MAE)
+	assert.Equal(t, -2.5, m.Bias)
+	assert.Equal(t, 50.0, m.MAPE)
+}
+
+func TestLoadWindow(t *testing.T) {
+	requests := 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	}, WithRetryPolicy(NoRetry), WithRateLimiter(nil))
+
+	load, err := NewLoad(France, c)
+	assert.Nil(t, err)
+
+	_, err = load.Fetch(genTime("202501010000"), genTime("202601010100"))
+	assert.True(t, errors.Is(err, ErrInvalidParameters), err)
+	assert.Zero(t, requests)
+}
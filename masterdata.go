@@ -0,0 +1,182 @@
+package entsoe
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ProductionUnit is a production unit listed by GetProductionAndGenerationUnits.
+type ProductionUnit struct {
+	EIC             string
+	Name            string
+	Location        string
+	PsrType         PsrType
+	BiddingZone     DomainType
+	ControlArea     DomainType
+	NominalPower_MW float64
+	Voltage_kV      float64
+	GeneratingUnits []GeneratingUnit
+}
+
+// GeneratingUnit is a generating unit of a ProductionUnit.
+type GeneratingUnit struct {
+	EIC             string
+	Name            string
+	Location        string
+	PsrType         PsrType
+	NominalPower_MW float64
+}
+
+// ProductionUnits flattens the time series of the document, one per production unit.
+// Missing or malformed nominal powers and voltages are reported as 0.
+func (d *ConfigurationMarketDocument) ProductionUnits() []ProductionUnit {
+	units := make([]ProductionUnit, 0, len(d.TimeSeries))
+	for _, ts := range d.TimeSeries {
+		unit := ProductionUnit{
+			EIC:             ts.RegisteredResourceMRID.Text,
+			Name:            ts.RegisteredResourceName,
+			Location:        ts.RegisteredResourceLocationName,
+			PsrType:         PsrType(ts.MktPSRType.PsrType),
+			BiddingZone:     DomainType(ts.BiddingZoneDomainMRID.Text),
+			ControlArea:     DomainType(ts.ControlAreaDomainMRID.Text),
+			NominalPower_MW: parseFloatOrZero(ts.MktPSRType.NominalIPPowerSystemResourcesNominalP.Text),
+			Voltage_kV:      parseFloatOrZero(ts.MktPSRType.ProductionPowerSystemResourcesHighVoltageLimit.Text),
+		}
+		for _, gu := range ts.MktPSRType.GeneratingUnitPowerSystemResources {
+			unit.GeneratingUnits = append(unit.GeneratingUnits, GeneratingUnit{
+				EIC:             gu.MRID.Text,
+				Name:            gu.Name,
+				Location:        gu.GeneratingUnitLocationName,
+				PsrType:         PsrType(gu.GeneratingUnitPSRTypePsrType),
+				NominalPower_MW: parseFloatOrZero(gu.NominalP.Text),
+			})
+		}
+		units = append(units, unit)
+	}
+	return units
+}
+
+func parseFloatOrZero(s string) float64 {
+	f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if err != nil {
+		return 0
+	}
+	return f
+}
+
+// decodeXML unmarshals documents which are not always valid UTF-8: master data
+// contains unit names encoded in Windows-1252 whatever the XML declaration says.
+// Invalid bytes are converted to UTF-8 before decoding, the declared charset is then ignored.
+func decodeXML(data []byte, v interface{}) error {
+	decoder := xml.NewDecoder(bytes.NewReader(toUTF8(data)))
+	decoder.CharsetReader = func(charset string, input io.Reader) (io.Reader, error) {
+		return input, nil
+	}
+	return decoder.Decode(v)
+}
+
+// windows1252 maps the 0x80-0x9F range where Windows-1252 differs from Latin-1.
+var windows1252 = [32]rune{
+	'€', 0x81, '‚', 'ƒ', '„', '…', '†', '‡', 'ˆ', '‰', 'Š', '‹', 'Œ', 0x8D, 'Ž', 0x8F,
+	0x90, '‘', '’', '“', '”', '•', '–', '—', '˜', '™', 'š', '›', 'œ', 0x9D, 'ž', 'Ÿ',
+}
+
+// toUTF8 keeps valid UTF-8 sequences and reads every other byte as Windows-1252.
+func toUTF8(data []byte) []byte {
+	if utf8.Valid(data) {
+		return data
+	}
+
+	buf := make([]byte, 0, len(data)+len(data)/8)
+	var tmp [utf8.UTFMax]byte
+	for len(data) > 0 {
+		r, size := utf8.DecodeRune(data)
+		if r == utf8.RuneError && size == 1 {
+			r = rune(data[0])
+			if r >= 0x80 && r <= 0x9F {
+				r = windows1252[r-0x80]
+			}
+		}
+		n := utf8.EncodeRune(tmp[:], r)
+		buf = append(buf, tmp[:n]...)
+		data = data[size:]
+	}
+	return buf
+}
+
+// ConfigurationMarketDocument is written by hand, zek fails on the illegal
+// UTF-8 found in unit names, see decodeXML.
+type ConfigurationMarketDocument struct {
+	XMLName                     xml.Name `xml:"Configuration_MarketDocument"`
+	Text                        string   `xml:",chardata"`
+	Xmlns                       string   `xml:"xmlns,attr"`
+	MRID                        string   `xml:"mRID"`                // 8a3e3e4d1d2c4b8f9c4f...
+	RevisionNumber              string   `xml:"revisionNumber"`      // 1
+	Type                        string   `xml:"type"`                // A95
+	ProcessProcessType          string   `xml:"process.processType"` // A39
+	SenderMarketParticipantMRID struct {
+		Text         string `xml:",chardata"` // 10X1001A1001A450
+		CodingScheme string `xml:"codingScheme,attr"`
+	} `xml:"sender_MarketParticipant.mRID"`
+	SenderMarketParticipantMarketRoleType string `xml:"sender_MarketParticipant.marketRole.type"` // A32
+	ReceiverMarketParticipantMRID         struct {
+		Text         string `xml:",chardata"` // 10X1001A1001A450
+		CodingScheme string `xml:"codingScheme,attr"`
+	} `xml:"receiver_MarketParticipant.mRID"`
+	ReceiverMarketParticipantMarketRoleType string `xml:"receiver_MarketParticipant.marketRole.type"` // A33
+	CreatedDateTime                         string `xml:"createdDateTime"`                            // 2024-02-29T08:45:12Z
+	TimeSeries                              []struct {
+		Text                            string `xml:",chardata"`
+		MRID                            string `xml:"mRID"`                              // 1, 2, 3
+		BusinessType                    string `xml:"businessType"`                      // B11
+		ImplementationDateAndOrTimeDate string `xml:"implementation_DateAndOrTime.date"` // 2017-01-01
+		BiddingZoneDomainMRID           struct {
+			Text         string `xml:",chardata"` // 10YCZ-CEPS-----N
+			CodingScheme string `xml:"codingScheme,attr"`
+		} `xml:"biddingZone_Domain.mRID"`
+		RegisteredResourceMRID struct {
+			Text         string `xml:",chardata"` // 27W-PU-EPC1----Y
+			CodingScheme string `xml:"codingScheme,attr"`
+		} `xml:"registeredResource.mRID"`
+		RegisteredResourceName         string `xml:"registeredResource.name"`          // EPC1
+		RegisteredResourceLocationName string `xml:"registeredResource.location.name"` // Pocerady
+		ControlAreaDomainMRID          struct {
+			Text         string `xml:",chardata"` // 10YCZ-CEPS-----N
+			CodingScheme string `xml:"codingScheme,attr"`
+		} `xml:"ControlArea_Domain.mRID"`
+		ProviderMarketParticipantMRID struct {
+			Text         string `xml:",chardata"` // 27XCEZ-ENERGY--B
+			CodingScheme string `xml:"codingScheme,attr"`
+		} `xml:"Provider_MarketParticipant.mRID"`
+		MktPSRType struct {
+			Text                                           string `xml:",chardata"`
+			PsrType                                        string `xml:"psrType"` // B02
+			ProductionPowerSystemResourcesHighVoltageLimit struct {
+				Text string `xml:",chardata"` // 400
+				Unit string `xml:"unit,attr"`
+			} `xml:"production_PowerSystemResources.highVoltageLimit"`
+			NominalIPPowerSystemResourcesNominalP struct {
+				Text string `xml:",chardata"` // 1000
+				Unit string `xml:"unit,attr"`
+			} `xml:"nominalIP_PowerSystemResources.nominalP"`
+			GeneratingUnitPowerSystemResources []struct {
+				Text string `xml:",chardata"`
+				MRID struct {
+					Text         string `xml:",chardata"` // 27W-GU-EPC1G1-X
+					CodingScheme string `xml:"codingScheme,attr"`
+				} `xml:"mRID"`
+				Name     string `xml:"name"` // EPC1_G1
+				NominalP struct {
+					Text string `xml:",chardata"` // 200
+					Unit string `xml:"unit,attr"`
+				} `xml:"nominalP"`
+				GeneratingUnitPSRTypePsrType string `xml:"generatingUnit_PSRType.psrType"` // B02
+				GeneratingUnitLocationName   string `xml:"generatingUnit_Location.name"`   // Pocerady
+			} `xml:"GeneratingUnit_PowerSystemResources"`
+		} `xml:"MktPSRType"`
+	} `xml:"TimeSeries"`
+}
@@ -0,0 +1,77 @@
+package entsoe
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testConfigurationMarketDocument = `<?xml version="1.0" encoding="UTF-8"?>
+<Configuration_MarketDocument xmlns="urn:iec62325.351:tc57wg16:451-6:configurationdocument:3:0">
+	<mRID>1</mRID>
+	<type>A95</type>
+	<TimeSeries>
+		<mRID>1</mRID>
+		<businessType>B11</businessType>
+		<implementation_DateAndOrTime.date>2017-01-01</implementation_DateAndOrTime.date>
+		<biddingZone_Domain.mRID codingScheme="A01">10YCZ-CEPS-----N</biddingZone_Domain.mRID>
+		<registeredResource.mRID codingScheme="A01">27W-PU-EPC1----Y</registeredResource.mRID>
+		<registeredResource.name>EPC1</registeredResource.name>
+		<registeredResource.location.name>D` + "\xfc" + `rnrohr, Ko` + "\x9a" + `ice</registeredResource.location.name>
+		<MktPSRType>
+			<psrType>B02</psrType>
+			<production_PowerSystemResources.highVoltageLimit unit="KVT">400</production_PowerSystemResources.highVoltageLimit>
+			<nominalIP_PowerSystemResources.nominalP unit="MAW">1000</nominalIP_PowerSystemResources.nominalP>
+			<GeneratingUnit_PowerSystemResources>
+				<mRID codingScheme="A01">27W-GU-EPC1G1-X</mRID>
+				<name>EPC1_G1</name>
+				<nominalP unit="MAW">200</nominalP>
+				<generatingUnit_PSRType.psrType>B02</generatingUnit_PSRType.psrType>
+			</GeneratingUnit_PowerSystemResources>
+		</MktPSRType>
+	</TimeSeries>
+</Configuration_MarketDocument>`
+
+func TestGetProductionAndGenerationUnitsInvalidUTF8(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "2017-01-01", r.URL.Query().Get(ParameterImplementationDateAndOrTime))
+		w.Write([]byte(testConfigurationMarketDocument))
+	})
+
+	doc, err := c.GetProductionAndGenerationUnits(DomainCZ, genTime("201701010000"), nil)
+	assert.Nil(t, err)
+	if !assert.NotNil(t, doc) {
+		return
+	}
+
+	units := doc.ProductionUnits()
+	assert.Len(t, units, 1)
+	assert.Equal(t, "27W-PU-EPC1----Y", units[0].EIC)
+	assert.Equal(t, "EPC1", units[0].Name)
+	assert.Equal(t, "Dürnrohr, Košice", units[0].Location)
+	assert.Equal(t, PsrTypeFossilBrownCoalLignite, units[0].PsrType)
+	assert.Equal(t, 1000.0, units[0].NominalPower_MW)
+	assert.Equal(t, 400.0, units[0].Voltage_kV)
+	assert.Len(t, units[0].GeneratingUnits, 1)
+	assert.Equal(t, 200.0, units[0].GeneratingUnits[0].NominalPower_MW)
+}
+
+func TestGetProductionAndGenerationUnitsImplementationDate(t *testing.T) {
+	var got string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		got = r.URL.Query().Get(ParameterImplementationDateAndOrTime)
+		w.Write([]byte(testConfigurationMarketDocument))
+	})
+
+	// the date is a calendar date, 2017-01-01 CET is not moved to 2016-12-31 UTC
+	_, err := c.GetProductionAndGenerationUnits(DomainCZ, time.Date(2017, 1, 1, 0, 0, 0, 0, time.FixedZone("CET", 3600)), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "2017-01-01", got)
+}
+
+func TestToUTF8KeepsValidSequences(t *testing.T) {
+	assert.Equal(t, "Počerady", string(toUTF8([]byte("Počerady"))))
+	assert.Equal(t, "€ é", string(toUTF8([]byte("\x80 \xe9"))))
+}
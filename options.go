@@ -0,0 +1,45 @@
+package entsoe
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ClientOption configures an EntsoeClient, see NewEntsoeClient.
+type ClientOption func(*EntsoeClient)
+
+// WithHTTPClient sets the http.Client used for every request.
+// Defaults to http.DefaultClient.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *EntsoeClient) {
+		if httpClient != nil {
+			c.httpClient = httpClient
+		}
+	}
+}
+
+// WithBaseURL points the client at another endpoint, for example the
+// ENTSO-E iop/test platform or a local httptest server.
+// Defaults to DefaultBaseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *EntsoeClient) {
+		c.baseURL = strings.TrimRight(baseURL, "?")
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *EntsoeClient) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithTimeout sets the overall timeout of a single HTTP request.
+// It is applied on a copy of the http.Client, the client passed
+// to WithHTTPClient is never modified.
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(c *EntsoeClient) {
+		c.timeout = timeout
+	}
+}
@@ -0,0 +1,101 @@
+package entsoe
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// https://transparency.entsoe.eu/content/static_content/Static%20content/web%20api/Guide.html#_authentication_and_authorisation
+// A security token sending more than 400 requests per minute is banned for 10 minutes.
+// The default limit stays below that quota, including the initial burst.
+const (
+	DefaultRateLimit = 380
+	DefaultRateBurst = 10
+)
+
+// RateLimiter is a token bucket safe for concurrent use. Share one limiter
+// between all clients using the same security token, see WithRateLimiter.
+type RateLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration // time to earn one token
+	burst    float64
+	tokens   float64
+	last     time.Time
+}
+
+// NewRateLimiter allows `requests` requests every `per`, with up to `burst`
+// requests sent back to back. A burst below 1 is treated as 1.
+func NewRateLimiter(requests int, per time.Duration, burst int) *RateLimiter {
+	if requests < 1 {
+		requests = 1
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{
+		interval: per / time.Duration(requests),
+		burst:    float64(burst),
+		tokens:   float64(burst),
+		last:     time.Now(),
+	}
+}
+
+// Wait blocks until a request may be sent or ctx is done.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	wait := l.reserve()
+	if wait <= 0 {
+		return nil
+	}
+	if err := sleepContext(ctx, wait); err != nil {
+		l.cancel()
+		return err
+	}
+	return nil
+}
+
+// reserve takes a token, possibly in advance, and returns how long to wait before using it.
+func (l *RateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if l.interval > 0 {
+		l.tokens += float64(now.Sub(l.last)) / float64(l.interval)
+	} else {
+		l.tokens = l.burst
+	}
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens * float64(l.interval))
+}
+
+// cancel gives back a token reserved by an aborted Wait.
+func (l *RateLimiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.tokens++
+}
+
+// WithRateLimit limits the client to `requests` requests every `per`.
+// Use WithRateLimiter instead to share the limit between several clients.
+func WithRateLimit(requests int, per time.Duration) ClientOption {
+	return func(c *EntsoeClient) {
+		c.limiter = NewRateLimiter(requests, per, DefaultRateBurst)
+	}
+}
+
+// WithRateLimiter makes the client draw from limiter, which may be shared
+// with other clients. A nil limiter disables client side rate limiting.
+func WithRateLimiter(limiter *RateLimiter) ClientOption {
+	return func(c *EntsoeClient) {
+		c.limiter = limiter
+	}
+}
@@ -0,0 +1,75 @@
+package entsoe
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiterBurstThenRate(t *testing.T) {
+	l := NewRateLimiter(100, time.Second, 5)
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		assert.Nil(t, l.Wait(context.Background()))
+	}
+	assert.Less(t, int64(time.Since(start)), int64(5*time.Millisecond))
+
+	// next 10 requests are paced at one every 10ms
+	for i := 0; i < 10; i++ {
+		assert.Nil(t, l.Wait(context.Background()))
+	}
+	assert.GreaterOrEqual(t, int64(time.Since(start)), int64(90*time.Millisecond))
+}
+
+func TestRateLimiterCancel(t *testing.T) {
+	l := NewRateLimiter(1, time.Hour, 1)
+	assert.Nil(t, l.Wait(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, l.Wait(ctx))
+}
+
+func TestRateLimiterSharedBetweenClients(t *testing.T) {
+	var mu sync.Mutex
+	var calls []time.Time
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		calls = append(calls, time.Now())
+		mu.Unlock()
+		w.Write([]byte(testPublicationMarketDocument))
+	}
+
+	limiter := NewRateLimiter(50, time.Second, 1)
+	c1 := newTestClient(t, handler, WithRateLimiter(limiter))
+	c2 := newTestClient(t, handler, WithRateLimiter(limiter))
+
+	var wg sync.WaitGroup
+	for _, c := range []*EntsoeClient{c1, c2, c1, c2} {
+		wg.Add(1)
+		go func(c *EntsoeClient) {
+			defer wg.Done()
+			_, err := c.GetDayAheadPrices(DomainFR, genTime("202603012300"), genTime("202603020100"))
+			assert.Nil(t, err)
+		}(c)
+	}
+	wg.Wait()
+
+	assert.Len(t, calls, 4)
+	first, last := calls[0], calls[0]
+	for _, c := range calls {
+		if c.Before(first) {
+			first = c
+		}
+		if c.After(last) {
+			last = c
+		}
+	}
+	// 1 token up front, then one every 20ms
+	assert.GreaterOrEqual(t, int64(last.Sub(first)), int64(50*time.Millisecond))
+}
@@ -0,0 +1,320 @@
+package entsoe
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+)
+
+// renewableTypes are the production types of RenewablesForecast.
+var renewableTypes = []PsrType{
+	PsrTypeSolar,
+	PsrTypeWindOffshore,
+	PsrTypeWindOnshore,
+}
+
+// renewableForecasts are the process types of the wind and solar forecasts,
+// from the earliest to the latest.
+var renewableForecasts = []ProcessType{
+	ProcessTypeDayAhead,
+	ProcessTypeIntradayTotal,
+	ProcessTypeIntradayProcess,
+}
+
+// DefaultVintageRetention is how long the vintages of a slot are kept by default,
+// counted back from the latest slot fetched.
+const DefaultVintageRetention = 7 * 24 * time.Hour
+
+// RenewablesForecast fetches the wind and solar forecasts of an area and the actual generation.
+// Every forecast value fetched is kept with its creation time, see Vintages.
+// It is safe for concurrent use.
+type RenewablesForecast struct {
+	client    *EntsoeClient
+	domain    DomainType
+	retention time.Duration
+
+	mu       sync.Mutex
+	vintages map[vintageKey][]Vintage
+	latest   int64 // latest slot with a vintage, in Unix seconds
+}
+
+// RenewablesForecastOption configures a RenewablesForecast.
+type RenewablesForecastOption func(*RenewablesForecast)
+
+// WithVintageRetention keeps the vintages of the slots starting at most d before
+// the latest slot fetched, DefaultVintageRetention by default. The vintages of older
+// slots are dropped, also when they have just been fetched. 0 keeps every vintage.
+func WithVintageRetention(d time.Duration) RenewablesForecastOption {
+	return func(r *RenewablesForecast) {
+		r.retention = d
+	}
+}
+
+type vintageKey struct {
+	processType ProcessType
+	psrType     PsrType
+	slot        int64 // slot start, in Unix seconds
+}
+
+// Vintage is a forecast value and the creation time of the first document it was fetched in.
+// The API has no publication time: the creation time is the createdDateTime of the document,
+// set when the API answers the request. It is then the time the value was first seen, the
+// actual publication is earlier, by up to the time between two fetches.
+type Vintage struct {
+	Created  time.Time
+	Value_MW float64
+}
+
+// RenewablesElement holds the forecasts and the actual generation of a 15-minute slot.
+type RenewablesElement struct {
+	Time time.Time
+	// Forecast_MW holds the forecast of every process type by production type.
+	Forecast_MW map[ProcessType]map[PsrType]float64
+	// Actual_MW holds the actual generation by production type.
+	Actual_MW map[PsrType]float64
+}
+
+// RenewablesForecastResult is the outcome of a RenewablesForecast fetch.
+type RenewablesForecastResult struct {
+	// Values holds the slots with at least one value, sorted by time.
+	Values []RenewablesElement
+	// Created holds the creation time of the forecast document of every process type.
+	// A document without one is left out, and its values are not kept as vintages.
+	Created map[ProcessType]time.Time
+	// ForecastErrors holds the forecasts which failed, by process type.
+	ForecastErrors map[ProcessType]error
+	// ActualErrors holds the actual generations which failed, by production type.
+	ActualErrors map[PsrType]error
+}
+
+func NewRenewablesForecast(area Area, client *EntsoeClient, opts ...RenewablesForecastOption) (*RenewablesForecast, error) {
+	domain, err := domain(string(area))
+	if err != nil {
+		return nil, err
+	}
+
+	r := &RenewablesForecast{
+		client:    client,
+		domain:    domain,
+		retention: DefaultVintageRetention,
+		vintages:  make(map[vintageKey][]Vintage),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r, nil
+}
+
+// Fetch returns the forecasts of the day-ahead (A01), current (A18) and intraday (A40)
+// processes and the actual generation of solar, wind offshore and wind onshore for [from, to),
+// at most one year: a longer window fails with an error wrapping ErrInvalidParameters,
+// without any request. Data not published for the area is no error, it is just missing.
+// A failing request does not fail the others, see the errors of RenewablesForecastResult.
+func (r *RenewablesForecast) Fetch(from, to time.Time) (*RenewablesForecastResult, error) {
+	return r.FetchContext(context.Background(), from, to)
+}
+
+// FetchContext is like Fetch but uses ctx for the requests.
+// Past the window check, the only error returned is ctx.Err(), along with what was fetched so far.
+func (r *RenewablesForecast) FetchContext(ctx context.Context, from, to time.Time) (*RenewablesForecastResult, error) {
+	if err := checkWindow(from, to); err != nil {
+		return nil, err
+	}
+
+	res := &RenewablesForecastResult{
+		Created:        make(map[ProcessType]time.Time),
+		ForecastErrors: make(map[ProcessType]error),
+		ActualErrors:   make(map[PsrType]error),
+	}
+
+	forecasts := make(map[ProcessType]map[PsrType]map[int64]float64)
+	actuals := make(map[PsrType]map[int64]float64)
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, processType := range renewableForecasts {
+		wg.Add(1)
+		go func(processType ProcessType) {
+			defer wg.Done()
+
+			doc, err := r.client.GetGenerationForecastsForWindAndSolarContext(ctx, processType, r.domain, from, to, nil)
+			series, created, err := renewableSeries(doc, err)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				logger.Error().Err(err).Str("processType", string(processType)).Msg("Error fetching wind and solar forecast")
+				res.ForecastErrors[processType] = err
+				return
+			}
+			forecasts[processType] = renewableValues(series)
+			if created.IsZero() {
+				logger.Warn().Str("processType", string(processType)).Msg("forecast without creation time, its vintages are not kept")
+				return
+			}
+			res.Created[processType] = created
+		}(processType)
+	}
+	for _, psrType := range renewableTypes {
+		wg.Add(1)
+		go func(psrType PsrType) {
+			defer wg.Done()
+
+			doc, err := r.client.GetAggregatedGenerationPerTypeContext(ctx, ProcessTypeRealised, psrType, r.domain, from, to)
+			series, _, err := renewableSeries(doc, err)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				logger.Error().Err(err).Str("psrType", string(psrType)).Msg("Error fetching generation")
+				res.ActualErrors[psrType] = err
+				return
+			}
+			actuals[psrType] = renewableValues(series)[psrType]
+		}(psrType)
+	}
+	wg.Wait()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, slot := range slots(from, to) {
+		k := slot.Unix()
+		e := RenewablesElement{
+			Time:        slot,
+			Forecast_MW: make(map[ProcessType]map[PsrType]float64),
+			Actual_MW:   make(map[PsrType]float64),
+		}
+		for processType, byType := range forecasts {
+			for psrType, values := range byType {
+				v, ok := values[k]
+				if !ok {
+					continue
+				}
+				if e.Forecast_MW[processType] == nil {
+					e.Forecast_MW[processType] = make(map[PsrType]float64)
+				}
+				e.Forecast_MW[processType][psrType] = v
+				// a forecast without creation time cannot be ordered with the others
+				if created, ok := res.Created[processType]; ok {
+					r.record(vintageKey{processType, psrType, k}, Vintage{Created: created, Value_MW: v})
+				}
+			}
+		}
+		for psrType, values := range actuals {
+			if v, ok := values[k]; ok {
+				e.Actual_MW[psrType] = v
+			}
+		}
+		if len(e.Forecast_MW) > 0 || len(e.Actual_MW) > 0 {
+			res.Values = append(res.Values, e)
+		}
+	}
+	r.prune()
+
+	return res, ctx.Err()
+}
+
+// FetchInterval is like FetchContext but takes the window as an Interval, such as a DeliveryDay.
+func (r *RenewablesForecast) FetchInterval(ctx context.Context, interval Interval) (*RenewablesForecastResult, error) {
+	return r.FetchContext(ctx, interval.Start, interval.End)
+}
+
+// Vintages returns the successive values of the forecast of a process type and production type
+// fetched so far for the 15-minute slot starting at t, sorted by creation time.
+// A value is only kept when it differs from the one before it, see Vintage: fetches
+// returning the same value under a later creation time add no vintage, and values
+// replaced between two fetches are never seen. The process types of a slot, from the
+// day-ahead to the intraday forecast, remain the way to follow a forecast to delivery.
+func (r *RenewablesForecast) Vintages(processType ProcessType, psrType PsrType, t time.Time) []Vintage {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Vintage(nil), r.vintages[vintageKey{processType, psrType, t.Unix()}]...)
+}
+
+// record adds a vintage of a slot, replacing a vintage of the same creation time,
+// and drops the vintages repeating the value before them. r.mu must be held.
+func (r *RenewablesForecast) record(key vintageKey, v Vintage) {
+	vintages := r.vintages[key]
+	i := sort.Search(len(vintages), func(i int) bool {
+		return !vintages[i].Created.Before(v.Created)
+	})
+	if i < len(vintages) && vintages[i].Created.Equal(v.Created) {
+		vintages[i] = v
+	} else {
+		vintages = append(vintages, Vintage{})
+		copy(vintages[i+1:], vintages[i:])
+		vintages[i] = v
+	}
+
+	n := 1
+	for _, v := range vintages[1:] {
+		if v.Value_MW != vintages[n-1].Value_MW {
+			vintages[n] = v
+			n++
+		}
+	}
+	r.vintages[key] = vintages[:n]
+
+	if key.slot > r.latest {
+		r.latest = key.slot
+	}
+}
+
+// prune drops the vintages of the slots older than the retention. r.mu must be held.
+func (r *RenewablesForecast) prune() {
+	if r.retention <= 0 {
+		return
+	}
+	oldest := r.latest - int64(r.retention/time.Second)
+	for key := range r.vintages {
+		if key.slot < oldest {
+			delete(r.vintages, key)
+		}
+	}
+}
+
+// renewableSeries returns the series of a document and its creation time.
+// A document without data is no error.
+func renewableSeries(doc *GLMarketDocument, err error) ([]Series, time.Time, error) {
+	if errors.Is(err, ErrNoMatchingData) {
+		return nil, time.Time{}, nil
+	}
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	series, err := doc.Series()
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	var created time.Time
+	if doc.CreatedDateTime != "" {
+		created, err = time.Parse(time.RFC3339, doc.CreatedDateTime)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+	}
+	return series, created, nil
+}
+
+// renewableValues returns the values of series by production type and 15-minute slot.
+func renewableValues(series []Series) map[PsrType]map[int64]float64 {
+	res := make(map[PsrType]map[int64]float64)
+	for _, s := range series {
+		values, ok := s.slotValues(resolution15m)
+		if !ok {
+			logger.Warn().Str("resolution", string(s.Resolution)).Msg("unsupported resolution, skipping time series")
+			continue
+		}
+		if res[s.PsrType] == nil {
+			res[s.PsrType] = make(map[int64]float64)
+		}
+		for k, v := range values {
+			res[s.PsrType][k] = v
+		}
+	}
+	return res
+}
@@ -0,0 +1,177 @@
+package entsoe
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenewablesForecast(t *testing.T) {
+	var mu sync.Mutex
+	dayAheadCreated, dayAheadSolar := "2026-03-01T10:00:00Z", 100.0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		switch DocumentType(q.Get(ParameterDocumentType)) {
+		case DocumentTypeWindAndSolarForecast:
+			switch ProcessType(q.Get(ParameterProcessType)) {
+			case ProcessTypeDayAhead:
+				mu.Lock()
+				created, solar := dayAheadCreated, dayAheadSolar
+				mu.Unlock()
+				w.Write([]byte(testGLDocument(DocumentTypeWindAndSolarForecast, created,
+					testSeries("A94", PsrTypeSolar, testEnd, ResolutionHour, solar),
+					testSeries("A94", PsrTypeWindOnshore, testEnd, ResolutionHour, 200),
+				)))
+			case ProcessTypeIntradayTotal:
+				w.Write([]byte(testGLDocument(DocumentTypeWindAndSolarForecast, "2026-03-01T20:00:00Z",
+					testSeries("A94", PsrTypeSolar, testEnd, ResolutionQuarter, 90, 95, 100, 105),
+				)))
+			case ProcessTypeIntradayProcess:
+				w.Write([]byte(testGLDocument(DocumentTypeWindAndSolarForecast, "",
+					testSeries("A94", PsrTypeWindOnshore, testEnd, ResolutionHour, 210),
+				)))
+			}
+		case DocumentTypeActualGenerationPerType:
+			switch PsrType(q.Get(ParameterPsrType)) {
+			case PsrTypeSolar:
+				w.Write([]byte(testGLDocument(DocumentTypeActualGenerationPerType, "2026-03-02T01:00:00Z",
+					testSeries("A94", PsrTypeSolar, testEnd, ResolutionQuarter, 80, 85, 90, 95),
+				)))
+			case PsrTypeWindOnshore:
+				w.WriteHeader(http.StatusServiceUnavailable)
+			default:
+				w.Write([]byte(testAcknowledgement("No matching data found")))
+			}
+		}
+	}, WithRetryPolicy(NoRetry), WithRateLimiter(nil))
+
+	forecast, err := NewRenewablesForecast(France, c)
+	assert.Nil(t, err)
+
+	res, err := forecast.Fetch(genTime("202603012300"), genTime("202603020000"))
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[ProcessType]error{}, res.ForecastErrors)
+	assert.Len(t, res.ActualErrors, 1)
+	assert.NotNil(t, res.ActualErrors[PsrTypeWindOnshore])
+	assert.Len(t, res.Created, 2)
+	assert.Equal(t, genTime("202603011000"), res.Created[ProcessTypeDayAhead].UTC())
+	assert.Equal(t, genTime("202603012000"), res.Created[ProcessTypeIntradayTotal].UTC())
+
+	if assert.Len(t, res.Values, 4) {
+		e := res.Values[1]
+		assert.Equal(t, genTime("202603012315"), e.Time.UTC())
+		assert.Equal(t, map[ProcessType]map[PsrType]float64{
+			ProcessTypeDayAhead:        {PsrTypeSolar: 100, PsrTypeWindOnshore: 200},
+			ProcessTypeIntradayTotal:   {PsrTypeSolar: 95},
+			ProcessTypeIntradayProcess: {PsrTypeWindOnshore: 210},
+		}, e.Forecast_MW)
+		assert.Equal(t, map[PsrType]float64{PsrTypeSolar: 85}, e.Actual_MW)
+	}
+
+	// a new day-ahead value adds a vintage, the same value created later does not
+	mu.Lock()
+	dayAheadCreated, dayAheadSolar = "2026-03-01T12:00:00Z", 110
+	mu.Unlock()
+	_, err = forecast.Fetch(genTime("202603012300"), genTime("202603020000"))
+	assert.Nil(t, err)
+	mu.Lock()
+	dayAheadCreated = "2026-03-01T14:00:00Z"
+	mu.Unlock()
+	_, err = forecast.Fetch(genTime("202603012300"), genTime("202603020000"))
+	assert.Nil(t, err)
+
+	vintages := forecast.Vintages(ProcessTypeDayAhead, PsrTypeSolar, genTime("202603012315"))
+	if assert.Len(t, vintages, 2) {
+		assert.Equal(t, genTime("202603011000"), vintages[0].Created.UTC())
+		assert.Equal(t, genTime("202603011200"), vintages[1].Created.UTC())
+		assert.Equal(t, 110.0, vintages[1].Value_MW)
+	}
+	assert.Len(t, forecast.Vintages(ProcessTypeIntradayTotal, PsrTypeSolar, genTime("202603012315")), 1)
+	// the intraday forecast has no creation time
+	assert.Empty(t, forecast.Vintages(ProcessTypeIntradayProcess, PsrTypeWindOnshore, genTime("202603012315")))
+}
+
+func TestRenewablesForecastVintageUnchanged(t *testing.T) {
+	var mu sync.Mutex
+	created := "2026-03-01T10:00:00Z"
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if DocumentType(q.Get(ParameterDocumentType)) != DocumentTypeWindAndSolarForecast ||
+			ProcessType(q.Get(ParameterProcessType)) != ProcessTypeDayAhead {
+			w.Write([]byte(testAcknowledgement("No matching data found")))
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		w.Write([]byte(testGLDocument(DocumentTypeWindAndSolarForecast, created,
+			testSeries("A94", PsrTypeSolar, testEnd, ResolutionHour, 100),
+		)))
+	}, WithRetryPolicy(NoRetry), WithRateLimiter(nil))
+
+	forecast, err := NewRenewablesForecast(France, c)
+	assert.Nil(t, err)
+
+	// the second fetch returns the same value in a document created an hour later
+	for _, fetch := range []struct{ created, want string }{
+		{"2026-03-01T10:00:00Z", "202603011000"},
+		{"2026-03-01T11:00:00Z", "202603011100"},
+	} {
+		mu.Lock()
+		created = fetch.created
+		mu.Unlock()
+		res, err := forecast.Fetch(genTime("202603012300"), genTime("202603020000"))
+		assert.Nil(t, err)
+		assert.Equal(t, genTime(fetch.want), res.Created[ProcessTypeDayAhead].UTC())
+	}
+
+	assert.Equal(t, []Vintage{{Created: genTime("202603011000"), Value_MW: 100}},
+		utcVintages(forecast.Vintages(ProcessTypeDayAhead, PsrTypeSolar, genTime("202603012300"))))
+}
+
+// utcVintages returns vintages with their creation times in UTC, to compare them.
+func utcVintages(vintages []Vintage) []Vintage {
+	for i := range vintages {
+		vintages[i].Created = vintages[i].Created.UTC()
+	}
+	return vintages
+}
+
+func TestRenewablesForecastVintageRetention(t *testing.T) {
+	forecast, err := NewRenewablesForecast(France, nil, WithVintageRetention(time.Hour))
+	assert.Nil(t, err)
+
+	created := genTime("202603011000")
+	key := func(t time.Time) vintageKey {
+		return vintageKey{ProcessTypeDayAhead, PsrTypeSolar, t.Unix()}
+	}
+
+	forecast.mu.Lock()
+	forecast.record(key(genTime("202603020000")), Vintage{Created: created, Value_MW: 100})
+	forecast.record(key(genTime("202603020100")), Vintage{Created: created, Value_MW: 100})
+	forecast.record(key(genTime("202603020115")), Vintage{Created: created, Value_MW: 100})
+	forecast.prune()
+	forecast.mu.Unlock()
+
+	assert.Empty(t, forecast.Vintages(ProcessTypeDayAhead, PsrTypeSolar, genTime("202603020000")))
+	assert.Len(t, forecast.Vintages(ProcessTypeDayAhead, PsrTypeSolar, genTime("202603020100")), 1)
+	assert.Len(t, forecast.Vintages(ProcessTypeDayAhead, PsrTypeSolar, genTime("202603020115")), 1)
+}
+
+func TestRenewablesForecastWindow(t *testing.T) {
+	requests := 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	}, WithRetryPolicy(NoRetry), WithRateLimiter(nil))
+
+	forecast, err := NewRenewablesForecast(France, c)
+	assert.Nil(t, err)
+
+	_, err = forecast.Fetch(genTime("202501010000"), genTime("202601010100"))
+	assert.True(t, errors.Is(err, ErrInvalidParameters), err)
+	assert.Zero(t, requests)
+}
@@ -0,0 +1,184 @@
+package entsoe
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// Aggregator reduces the prices of a resampling bucket to one value.
+// It is never called with an empty slice.
+type Aggregator func(values []float64) float64
+
+// Mean is the arithmetic mean, the usual aggregator for prices of slots of equal length.
+func Mean(values []float64) float64 {
+	sum := 0.0
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
+
+// Min is the lowest value.
+func Min(values []float64) float64 {
+	res := math.Inf(1)
+	for _, v := range values {
+		res = math.Min(res, v)
+	}
+	return res
+}
+
+// Max is the highest value.
+func Max(values []float64) float64 {
+	res := math.Inf(-1)
+	for _, v := range values {
+		res = math.Max(res, v)
+	}
+	return res
+}
+
+// Resample groups prices by buckets of the given resolution and aggregates each bucket.
+// Buckets are aligned on the market time zone of area, see Area.Location: hours,
+// midnight for ResolutionDay, Monday midnight for ResolutionWeek, the first of the
+// month for ResolutionMonth and the first of January for ResolutionYear. Days are
+// therefore the delivery days of area, 23 or 25 hours long on DST changes.
+// The result is sorted by time, each element at the start of its bucket in the market time zone.
+// Empty buckets are left out.
+func Resample(prices []DayAheadElement, resolution ResolutionType, aggregator Aggregator, area Area) ([]DayAheadElement, error) {
+	loc, err := area.Location()
+	if err != nil {
+		return nil, err
+	}
+	bucket, err := bucketFunc(resolution, loc)
+	if err != nil {
+		return nil, err
+	}
+	return resample(prices, bucket, nil, aggregator), nil
+}
+
+// PeakOption configures the peak hours of PeakIndex and OffPeakIndex.
+type PeakOption func(*peakHours)
+
+// peakHours are the hours from 08:00 to 20:00, Monday to Friday unless weekends.
+type peakHours struct {
+	weekends bool
+}
+
+// WithWeekends also counts the hours from 08:00 to 20:00 of Saturdays and Sundays
+// as peak hours, only the ones of Monday to Friday are by default.
+func WithWeekends() PeakOption {
+	return func(p *peakHours) {
+		p.weekends = true
+	}
+}
+
+// BaseIndex returns the average price of every delivery day of area.
+func BaseIndex(prices []DayAheadElement, area Area) ([]DayAheadElement, error) {
+	loc, err := area.Location()
+	if err != nil {
+		return nil, err
+	}
+	return resample(prices, startOfDay(loc), nil, Mean), nil
+}
+
+// PeakIndex returns the average price of every delivery day of area between 08:00 and 20:00,
+// from Monday to Friday. Weekend days have no peak index, unless WithWeekends.
+func PeakIndex(prices []DayAheadElement, area Area, opts ...PeakOption) ([]DayAheadElement, error) {
+	loc, err := area.Location()
+	if err != nil {
+		return nil, err
+	}
+	return resample(prices, startOfDay(loc), isPeak(loc, opts...), Mean), nil
+}
+
+// OffPeakIndex returns the average price of every delivery day of area outside of the peak hours:
+// before 08:00 and from 20:00, and the whole day on weekends, unless WithWeekends.
+func OffPeakIndex(prices []DayAheadElement, area Area, opts ...PeakOption) ([]DayAheadElement, error) {
+	loc, err := area.Location()
+	if err != nil {
+		return nil, err
+	}
+	peak := isPeak(loc, opts...)
+	return resample(prices, startOfDay(loc), func(t time.Time) bool { return !peak(t) }, Mean), nil
+}
+
+func isPeak(loc *time.Location, opts ...PeakOption) func(time.Time) bool {
+	var p peakHours
+	for _, opt := range opts {
+		opt(&p)
+	}
+	return func(t time.Time) bool {
+		t = t.In(loc)
+		if !p.weekends && (t.Weekday() == time.Saturday || t.Weekday() == time.Sunday) {
+			return false
+		}
+		return t.Hour() >= 8 && t.Hour() < 20
+	}
+}
+
+func startOfDay(loc *time.Location) func(time.Time) time.Time {
+	return func(t time.Time) time.Time {
+		year, month, day := t.In(loc).Date()
+		return time.Date(year, month, day, 0, 0, 0, 0, loc)
+	}
+}
+
+// bucketFunc returns the function giving the start of the bucket of a time.
+func bucketFunc(resolution ResolutionType, loc *time.Location) (func(time.Time) time.Time, error) {
+	switch resolution {
+	case ResolutionDay:
+		return startOfDay(loc), nil
+	case ResolutionWeek:
+		day := startOfDay(loc)
+		return func(t time.Time) time.Time {
+			start := day(t)
+			return start.AddDate(0, 0, -(int(start.Weekday())+6)%7)
+		}, nil
+	case ResolutionMonth:
+		return func(t time.Time) time.Time {
+			year, month, _ := t.In(loc).Date()
+			return time.Date(year, month, 1, 0, 0, 0, 0, loc)
+		}, nil
+	case ResolutionYear:
+		return func(t time.Time) time.Time {
+			return time.Date(t.In(loc).Year(), time.January, 1, 0, 0, 0, 0, loc)
+		}, nil
+	}
+
+	d, err := resolution.Duration()
+	if err != nil {
+		return nil, err
+	}
+	if d > time.Hour || time.Hour%d != 0 {
+		return nil, fmt.Errorf("unsupported resampling resolution %s", resolution)
+	}
+	return func(t time.Time) time.Time {
+		return t.Truncate(d).In(loc)
+	}, nil
+}
+
+func resample(prices []DayAheadElement, bucket func(time.Time) time.Time, keep func(time.Time) bool, aggregator Aggregator) []DayAheadElement {
+	buckets := make(map[int64][]float64)
+	starts := make(map[int64]time.Time)
+	for _, p := range prices {
+		if keep != nil && !keep(p.Time) {
+			continue
+		}
+		start := bucket(p.Time)
+		buckets[start.Unix()] = append(buckets[start.Unix()], p.Price_eur_per_MWh)
+		starts[start.Unix()] = start
+	}
+
+	res := make([]DayAheadElement, 0, len(buckets))
+	for k, values := range buckets {
+		res = append(res, DayAheadElement{
+			Time:              starts[k],
+			Price_eur_per_MWh: aggregator(values),
+		})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Time.Before(res[j].Time)
+	})
+	return res
+}
@@ -0,0 +1,168 @@
+package entsoe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testSlots returns 15-minute prices for [from, to), priced with their local hour in loc.
+func testSlots(from, to time.Time, loc *time.Location) []DayAheadElement {
+	var prices []DayAheadElement
+	for t := from; t.Before(to); t = t.Add(resolution15m) {
+		prices = append(prices, DayAheadElement{Time: t, Price_eur_per_MWh: float64(t.In(loc).Hour())})
+	}
+	return prices
+}
+
+func count(values []float64) float64 {
+	return float64(len(values))
+}
+
+func TestResampleHourly(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	prices := []DayAheadElement{
+		{Time: genTime("202603012300"), Price_eur_per_MWh: 10},
+		{Time: genTime("202603012315"), Price_eur_per_MWh: 20},
+		{Time: genTime("202603012330"), Price_eur_per_MWh: 30},
+		{Time: genTime("202603012345"), Price_eur_per_MWh: 40},
+		{Time: genTime("202603020000"), Price_eur_per_MWh: 50},
+	}
+
+	res, err := Resample(prices, ResolutionHour, Mean, France)
+	assert.Nil(t, err)
+	assert.Equal(t, []DayAheadElement{
+		{Time: genTime("202603012300").In(paris), Price_eur_per_MWh: 25},
+		{Time: genTime("202603020000").In(paris), Price_eur_per_MWh: 50},
+	}, res)
+
+	res, err = Resample(prices, ResolutionHour, Max, France)
+	assert.Nil(t, err)
+	assert.Equal(t, 40.0, res[0].Price_eur_per_MWh)
+
+	res, err = Resample(prices, ResolutionHalfHour, Min, France)
+	assert.Nil(t, err)
+	assert.Len(t, res, 3)
+	assert.Equal(t, 30.0, res[1].Price_eur_per_MWh)
+
+	_, err = Resample(prices, "PT7M", Mean, France)
+	assert.NotNil(t, err)
+	_, err = Resample(prices, "P2D", Mean, France)
+	assert.NotNil(t, err)
+	_, err = Resample(prices, ResolutionHour, Mean, "XX")
+	assert.NotNil(t, err)
+}
+
+func TestResampleDST(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	// 2026-03-29 is 23 hours long in Paris, 2025-10-26 is 25 hours long
+	for _, tt := range []struct {
+		day            time.Time
+		slots, offPeak float64
+	}{
+		{time.Date(2026, 3, 28, 0, 0, 0, 0, paris), 96, 48},
+		{time.Date(2026, 3, 29, 0, 0, 0, 0, paris), 92, 44},
+		{time.Date(2025, 10, 26, 0, 0, 0, 0, paris), 100, 52},
+	} {
+		prices := testSlots(tt.day.AddDate(0, 0, -1), tt.day.AddDate(0, 0, 2), paris)
+
+		res, err := Resample(prices, ResolutionDay, count, France)
+		assert.Nil(t, err)
+		if assert.Len(t, res, 3) {
+			assert.Equal(t, tt.day, res[1].Time)
+			assert.Equal(t, tt.slots, res[1].Price_eur_per_MWh, tt.day)
+		}
+
+		// these are weekend days
+		peak, err := PeakIndex(prices, France, WithWeekends())
+		assert.Nil(t, err)
+		offPeak, err := OffPeakIndex(prices, France, WithWeekends())
+		assert.Nil(t, err)
+		base, err := BaseIndex(prices, France)
+		assert.Nil(t, err)
+		if assert.Len(t, peak, 3) && assert.Len(t, offPeak, 3) && assert.Len(t, base, 3) {
+			// prices are the local hour: 8 to 19 during peak hours
+			assert.Equal(t, 13.5, peak[1].Price_eur_per_MWh)
+			assert.Equal(t, tt.day, peak[1].Time)
+			assert.Equal(t, tt.day, offPeak[1].Time)
+
+			offPeakSlots := resample(prices, startOfDay(paris), func(t time.Time) bool { return !isPeak(paris, WithWeekends())(t) }, count)
+			assert.Equal(t, tt.offPeak, offPeakSlots[1].Price_eur_per_MWh, tt.day)
+
+			// the base index weighs every slot of the day
+			assert.InDelta(t, (peak[1].Price_eur_per_MWh*48+offPeak[1].Price_eur_per_MWh*tt.offPeak)/tt.slots, base[1].Price_eur_per_MWh, 1e-9)
+		}
+	}
+}
+
+func TestResampleWeeklyMonthlyYearly(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	prices := testSlots(time.Date(2026, 2, 1, 0, 0, 0, 0, paris), time.Date(2026, 4, 1, 0, 0, 0, 0, paris), paris)
+
+	res, err := Resample(prices, ResolutionMonth, count, France)
+	assert.Nil(t, err)
+	assert.Equal(t, []DayAheadElement{
+		{Time: time.Date(2026, 2, 1, 0, 0, 0, 0, paris), Price_eur_per_MWh: 28 * 96},
+		{Time: time.Date(2026, 3, 1, 0, 0, 0, 0, paris), Price_eur_per_MWh: 31*96 - 4},
+	}, res)
+
+	res, err = Resample(prices, ResolutionYear, count, France)
+	assert.Nil(t, err)
+	assert.Len(t, res, 1)
+	assert.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 0, paris), res[0].Time)
+
+	res, err = Resample(prices, ResolutionWeek, count, France)
+	assert.Nil(t, err)
+	// 2026-02-01 is a Sunday
+	assert.Equal(t, time.Date(2026, 1, 26, 0, 0, 0, 0, paris), res[0].Time)
+	assert.Equal(t, 96.0, res[0].Price_eur_per_MWh)
+	assert.Equal(t, time.Date(2026, 2, 2, 0, 0, 0, 0, paris), res[1].Time)
+	assert.Equal(t, time.Monday, res[1].Time.Weekday())
+}
+
+func TestPeakIndexWeekdays(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	// from Monday 2026-03-02 to Sunday 2026-03-08
+	monday := time.Date(2026, 3, 2, 0, 0, 0, 0, paris)
+	prices := testSlots(monday, monday.AddDate(0, 0, 7), paris)
+
+	peak, err := PeakIndex(prices, France)
+	assert.Nil(t, err)
+	if assert.Len(t, peak, 5) {
+		assert.Equal(t, monday, peak[0].Time)
+		assert.Equal(t, time.Friday, peak[4].Time.Weekday())
+		assert.Equal(t, 13.5, peak[4].Price_eur_per_MWh)
+	}
+
+	// weekends are off-peak all day
+	offPeak, err := OffPeakIndex(prices, France)
+	assert.Nil(t, err)
+	if assert.Len(t, offPeak, 7) {
+		assert.Equal(t, time.Saturday, offPeak[5].Time.Weekday())
+		assert.Equal(t, 11.5, offPeak[5].Price_eur_per_MWh)
+	}
+
+	peak, err = PeakIndex(prices, France, WithWeekends())
+	assert.Nil(t, err)
+	assert.Len(t, peak, 7)
+
+	_, err = PeakIndex(prices, "XX")
+	assert.NotNil(t, err)
+}
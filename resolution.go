@@ -0,0 +1,122 @@
+package entsoe
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// resolution is a parsed ISO 8601 duration: a calendar part, applied first,
+// and a clock part.
+type resolution struct {
+	years  int
+	months int
+	days   int
+	clock  time.Duration
+}
+
+// parse reads an ISO 8601 duration such as PT15M, P1D, P7D, P1M, P1Y or P1DT12H.
+// Fractions and negative durations are not supported.
+func (r ResolutionType) parse() (resolution, error) {
+	var res resolution
+
+	s := string(r)
+	if len(s) < 2 || s[0] != 'P' || strings.HasSuffix(s, "T") {
+		return res, fmt.Errorf("invalid resolution %q", s)
+	}
+
+	inTime := false
+	for s = s[1:]; len(s) > 0; {
+		if s[0] == 'T' {
+			if inTime {
+				return res, fmt.Errorf("invalid resolution %q", r)
+			}
+			inTime = true
+			s = s[1:]
+			continue
+		}
+
+		i := 0
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+		if i == 0 || i == len(s) {
+			return res, fmt.Errorf("invalid resolution %q", r)
+		}
+		n, err := strconv.Atoi(s[:i])
+		if err != nil {
+			return res, fmt.Errorf("invalid resolution %q: %w", r, err)
+		}
+
+		switch unit := s[i]; {
+		case !inTime && unit == 'Y':
+			res.years += n
+		case !inTime && unit == 'M':
+			res.months += n
+		case !inTime && unit == 'W':
+			res.days += 7 * n
+		case !inTime && unit == 'D':
+			res.days += n
+		case inTime && unit == 'H':
+			res.clock += time.Duration(n) * time.Hour
+		case inTime && unit == 'M':
+			res.clock += time.Duration(n) * time.Minute
+		case inTime && unit == 'S':
+			res.clock += time.Duration(n) * time.Second
+		default:
+			return res, fmt.Errorf("invalid resolution %q", r)
+		}
+		s = s[i+1:]
+	}
+
+	if res == (resolution{}) {
+		return res, fmt.Errorf("invalid resolution %q: zero duration", r)
+	}
+	return res, nil
+}
+
+// Duration returns the fixed length of the resolution.
+// Days are 24 hours, while a local delivery day lasts 23 or 25 hours on DST changes.
+// Months and years have no fixed length and return an error. Use Step in the market
+// time zone of the area for daily and longer resolutions.
+func (r ResolutionType) Duration() (time.Duration, error) {
+	res, err := r.parse()
+	if err != nil {
+		return 0, err
+	}
+	if res.years != 0 || res.months != 0 {
+		return 0, fmt.Errorf("resolution %s has no fixed duration", r)
+	}
+	return time.Duration(res.days)*24*time.Hour + res.clock, nil
+}
+
+// Step returns t moved forward by n resolutions.
+// Days, months and years are calendar steps in the location of t, keeping its time
+// of day, and days past the end of a shorter month are clamped to its last day.
+// Step t in the market time zone of the area, see Area.Location: in UTC, the
+// start of a CET month or day drifts by an hour across DST changes.
+func (r ResolutionType) Step(t time.Time, n int) (time.Time, error) {
+	res, err := r.parse()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if months := n * (12*res.years + res.months); months != 0 {
+		t = addMonths(t, months)
+	}
+	return t.AddDate(0, 0, n*res.days).Add(time.Duration(n) * res.clock), nil
+}
+
+func addMonths(t time.Time, months int) time.Time {
+	year, month, day := t.Date()
+	target := time.Date(year, month+time.Month(months), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	if last := daysInMonth(target); day > last {
+		day = last
+	}
+	return target.AddDate(0, 0, day-1)
+}
+
+func daysInMonth(t time.Time) int {
+	return time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
@@ -0,0 +1,102 @@
+package entsoe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolutionDuration(t *testing.T) {
+	tests := map[ResolutionType]time.Duration{
+		ResolutionMinute:      time.Minute,
+		ResolutionFiveMinutes: 5 * time.Minute,
+		ResolutionQuarter:     15 * time.Minute,
+		ResolutionHalfHour:    30 * time.Minute,
+		ResolutionHour:        time.Hour,
+		ResolutionDay:         24 * time.Hour,
+		ResolutionWeek:        7 * 24 * time.Hour,
+		"P1W":                 7 * 24 * time.Hour,
+		"P1DT12H":             36 * time.Hour,
+		"PT1H30M10S":          time.Hour + 30*time.Minute + 10*time.Second,
+	}
+	for resolution, want := range tests {
+		got, err := resolution.Duration()
+		assert.Nil(t, err, resolution)
+		assert.Equal(t, want, got, resolution)
+	}
+
+	for _, resolution := range []ResolutionType{ResolutionMonth, ResolutionYear} {
+		_, err := resolution.Duration()
+		assert.NotNil(t, err, resolution)
+	}
+}
+
+func TestResolutionInvalid(t *testing.T) {
+	for _, resolution := range []ResolutionType{"", "P", "PT", "15M", "PT15", "P1H", "PT1D", "P1DT", "PT0M", "PT1M1", "P1TT1H", "P-1D"} {
+		_, err := resolution.parse()
+		assert.NotNil(t, err, resolution)
+
+		_, err = GetPointTime(genTime("202603012300"), 1, resolution)
+		assert.NotNil(t, err, resolution)
+	}
+}
+
+func TestGetPointTime(t *testing.T) {
+	tests := []struct {
+		start      string
+		position   int
+		resolution ResolutionType
+		want       string
+	}{
+		{"202603012300", 1, ResolutionQuarter, "202603012300"},
+		{"202603012300", 5, ResolutionQuarter, "202603020000"},
+		{"202603012300", 61, ResolutionMinute, "202603020000"},
+		{"202603012300", 13, ResolutionFiveMinutes, "202603020000"},
+		{"202603012300", 3, ResolutionDay, "202603032300"},
+		{"202603012300", 2, ResolutionWeek, "202603082300"},
+		{"201601150000", 2, ResolutionMonth, "201602150000"},
+		{"201601300000", 2, ResolutionMonth, "201602290000"},
+		{"201601310000", 13, ResolutionMonth, "201701310000"},
+		{"201602290000", 2, ResolutionYear, "201702280000"},
+		// in UTC, the calendar is the UTC one: 2026-02-28T23:00Z is no month start
+		{"202602282300", 2, ResolutionMonth, "202603282300"},
+	}
+	for _, tt := range tests {
+		got, err := GetPointTime(genTime(tt.start), tt.position, tt.resolution)
+		assert.Nil(t, err)
+		assert.Equal(t, genTime(tt.want), got, "%s + %d x %s", tt.start, tt.position-1, tt.resolution)
+	}
+
+	_, err := GetPointTime(genTime("202603012300"), 0, ResolutionHour)
+	assert.NotNil(t, err)
+}
+
+func TestGetPointTimeMarketTimeZone(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	tests := []struct {
+		start      time.Time
+		position   int
+		resolution ResolutionType
+		want       string
+	}{
+		// local months and days, across DST changes
+		{time.Date(2026, 3, 1, 0, 0, 0, 0, paris), 2, ResolutionMonth, "202603312200"},
+		{time.Date(2026, 3, 28, 0, 0, 0, 0, paris), 3, ResolutionDay, "202603292200"},
+		{time.Date(2025, 10, 25, 0, 0, 0, 0, paris), 3, ResolutionDay, "202510262300"},
+		{time.Date(2026, 3, 23, 0, 0, 0, 0, paris), 2, ResolutionWeek, "202603292200"},
+		{time.Date(2016, 1, 31, 0, 0, 0, 0, paris), 2, ResolutionMonth, "201602282300"},
+		{time.Date(2016, 1, 1, 0, 0, 0, 0, paris), 2, ResolutionYear, "201612312300"},
+		// clock steps are absolute, 2026-03-29 02:00 does not exist in Paris
+		{time.Date(2026, 3, 29, 0, 0, 0, 0, paris), 5, ResolutionHour, "202603290300"},
+	}
+	for _, tt := range tests {
+		got, err := GetPointTime(tt.start, tt.position, tt.resolution)
+		assert.Nil(t, err)
+		assert.Equal(t, genTime(tt.want), got.UTC(), "%s + %d x %s", tt.start, tt.position-1, tt.resolution)
+	}
+}
@@ -0,0 +1,70 @@
+package entsoe
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryOnTransientStatus(t *testing.T) {
+	calls := 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte("<html>busy</html>"))
+			return
+		}
+		w.Write([]byte(testPublicationMarketDocument))
+	}, WithRetryPolicy(RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}))
+
+	doc, err := c.GetDayAheadPrices(DomainFR, genTime("202603012300"), genTime("202603020100"))
+	assert.Nil(t, err)
+	assert.NotNil(t, doc)
+	assert.Equal(t, 3, calls)
+}
+
+func TestRetryGivesUpOnPermanentStatus(t *testing.T) {
+	calls := 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte("<html>forbidden</html>"))
+	}, WithRetryPolicy(RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}))
+
+	doc, err := c.GetDayAheadPrices(DomainFR, genTime("202603012300"), genTime("202603020100"))
+	assert.Nil(t, doc)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "403")
+	assert.Equal(t, 1, calls)
+}
+
+func TestRetryAfterAboveMaxDelay(t *testing.T) {
+	calls := 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Retry-After", "600")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}, WithRetryPolicy(RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Second}))
+
+	_, err := c.GetDayAheadPrices(DomainFR, genTime("202603012300"), genTime("202603020100"))
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := RetryPolicy{BaseDelay: time.Second, MaxDelay: 5 * time.Second}
+	assert.Equal(t, time.Second, p.backoff(1))
+	assert.Equal(t, 2*time.Second, p.backoff(2))
+	assert.Equal(t, 4*time.Second, p.backoff(3))
+	assert.Equal(t, 5*time.Second, p.backoff(4))
+
+	p.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		d := p.backoff(2)
+		assert.True(t, d > time.Second-1 && d <= 2*time.Second)
+	}
+}
@@ -0,0 +1,346 @@
+package entsoe
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const timeIntervalLayout = "2006-01-02T15:04Z"
+
+// Series is a time series of any market document, with its points converted
+// to timestamped values.
+type Series struct {
+	MRID                   string
+	BusinessType           BusinessType
+	PsrType                PsrType
+	InDomain               DomainType
+	OutDomain              DomainType
+	Unit                   string // measure unit of the values: MAW, MWH, ...
+	Currency               string // set for prices, the value is then a price per Unit
+	CurveType              CurveType
+	Resolution             ResolutionType
+	ClassificationPosition string // auction position, when several are published for the same domain
+	Start                  time.Time
+	End                    time.Time
+	Points                 []SeriesPoint
+}
+
+// SeriesPoint is a value starting at Time and lasting one resolution step,
+// or until the next point for the A03 series of unavailability documents.
+type SeriesPoint struct {
+	Time  time.Time
+	Value float64
+}
+
+// rawPoint is a point of any of the generated document structs.
+type rawPoint struct {
+	position string
+	value    string
+}
+
+// parsePeriod turns the points of a period into SeriesPoints sorted by time,
+// according to the curve type of the series:
+//   - A01 (sequential fixed size blocks) and A02 (points) are taken as they are,
+//     positions without a point are left out.
+//   - A03 (variable sized blocks) only publishes a point when the value changes,
+//     with expandBlocks it is expanded to one point per resolution step until the
+//     end of the period. Without, the points are taken as they are, each holding
+//     until the next one.
+//
+// Points without a value are skipped. The points are stepped in loc, the market
+// time zone of the series, so that daily, monthly and yearly points stay on the
+// local delivery days across DST changes, see ResolutionType.Step. Their times are UTC.
+func parsePeriod(start, end string, resolution ResolutionType, curveType CurveType, points []rawPoint, loc *time.Location, expandBlocks bool) (time.Time, time.Time, []SeriesPoint, error) {
+	startTime, err := time.Parse(timeIntervalLayout, start)
+	if err != nil {
+		return time.Time{}, time.Time{}, nil, err
+	}
+	endTime, err := time.Parse(timeIntervalLayout, end)
+	if err != nil {
+		return time.Time{}, time.Time{}, nil, err
+	}
+
+	values := make(map[int]float64, len(points))
+	for _, point := range points {
+		value := strings.TrimSpace(point.value)
+		if value == "" {
+			continue
+		}
+
+		position, err := strconv.Atoi(strings.TrimSpace(point.position))
+		if err != nil {
+			return time.Time{}, time.Time{}, nil, fmt.Errorf("invalid point position %q: %w", point.position, err)
+		}
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return time.Time{}, time.Time{}, nil, fmt.Errorf("invalid point value %q: %w", point.value, err)
+		}
+		values[position] = v
+	}
+	if len(values) == 0 {
+		return startTime, endTime, []SeriesPoint{}, nil
+	}
+
+	pointTime := func(position int) (time.Time, error) {
+		t, err := GetPointTime(startTime.In(loc), position, resolution)
+		return t.UTC(), err
+	}
+
+	if curveType == CurveTypeVariableSizedBlock && expandBlocks {
+		res := make([]SeriesPoint, 0, len(values))
+		var value float64
+		started := false
+		for position := 1; ; position++ {
+			t, err := pointTime(position)
+			if err != nil {
+				return time.Time{}, time.Time{}, nil, err
+			}
+			if !t.Before(endTime) {
+				break
+			}
+			if v, ok := values[position]; ok {
+				value = v
+				started = true
+			}
+			if started {
+				res = append(res, SeriesPoint{Time: t, Value: value})
+			}
+		}
+		return startTime, endTime, res, nil
+	}
+
+	res := make([]SeriesPoint, 0, len(values))
+	for position, v := range values {
+		t, err := pointTime(position)
+		if err != nil {
+			return time.Time{}, time.Time{}, nil, err
+		}
+		res = append(res, SeriesPoint{Time: t, Value: v})
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Time.Before(res[j].Time)
+	})
+	return startTime, endTime, res, nil
+}
+
+// Series converts the time series of the document, one Series per period.
+// Consumption series carry OutDomain, production series InDomain.
+func (d *GLMarketDocument) Series() ([]Series, error) {
+	res := make([]Series, 0, len(d.TimeSeries))
+	for _, ts := range d.TimeSeries {
+		for _, period := range ts.Period {
+			points := make([]rawPoint, len(period.Point))
+			for i, p := range period.Point {
+				points[i] = rawPoint{position: p.Position, value: p.Quantity}
+			}
+
+			resolution := ResolutionType(period.Resolution)
+			loc := domainLocation(DomainType(ts.InBiddingZoneDomainMRID.Text), DomainType(ts.OutBiddingZoneDomainMRID.Text))
+			start, end, parsed, err := parsePeriod(period.TimeInterval.Start, period.TimeInterval.End, resolution, CurveType(ts.CurveType), points, loc, true)
+			if err != nil {
+				return nil, err
+			}
+
+			res = append(res, Series{
+				MRID:         ts.MRID,
+				BusinessType: BusinessType(ts.BusinessType),
+				PsrType:      PsrType(ts.MktPSRType.PsrType),
+				InDomain:     DomainType(ts.InBiddingZoneDomainMRID.Text),
+				OutDomain:    DomainType(ts.OutBiddingZoneDomainMRID.Text),
+				Unit:         ts.QuantityMeasureUnitName,
+				CurveType:    CurveType(ts.CurveType),
+				Resolution:   resolution,
+				Start:        start,
+				End:          end,
+				Points:       parsed,
+			})
+		}
+	}
+	return res, nil
+}
+
+// Series converts the time series of the document, one Series per period.
+// Price series (day-ahead prices, auction revenues, ...) carry a Currency,
+// the others are quantities.
+func (d *PublicationMarketDocument) Series() ([]Series, error) {
+	res := make([]Series, 0, len(d.TimeSeries))
+	for _, ts := range d.TimeSeries {
+		for _, period := range ts.Period {
+			isPrice := false
+			for _, p := range period.Point {
+				if strings.TrimSpace(p.PriceAmount) != "" {
+					isPrice = true
+					break
+				}
+			}
+
+			points := make([]rawPoint, len(period.Point))
+			for i, p := range period.Point {
+				if isPrice {
+					points[i] = rawPoint{position: p.Position, value: p.PriceAmount}
+				} else {
+					points[i] = rawPoint{position: p.Position, value: p.Quantity}
+				}
+			}
+
+			resolution := ResolutionType(period.Resolution)
+			loc := domainLocation(DomainType(ts.InDomainMRID.Text), DomainType(ts.OutDomainMRID.Text))
+			start, end, parsed, err := parsePeriod(period.TimeInterval.Start, period.TimeInterval.End, resolution, CurveType(ts.CurveType), points, loc, true)
+			if err != nil {
+				return nil, err
+			}
+
+			s := Series{
+				MRID:                   ts.MRID,
+				BusinessType:           BusinessType(ts.BusinessType),
+				InDomain:               DomainType(ts.InDomainMRID.Text),
+				OutDomain:              DomainType(ts.OutDomainMRID.Text),
+				Unit:                   ts.QuantityMeasureUnitName,
+				CurveType:              CurveType(ts.CurveType),
+				Resolution:             resolution,
+				ClassificationPosition: ts.ClassificationSequenceAttributeInstanceComponentPosition,
+				Start:                  start,
+				End:                    end,
+				Points:                 parsed,
+			}
+			if isPrice {
+				s.Unit = ts.PriceMeasureUnitName
+				s.Currency = ts.CurrencyUnitName
+			}
+			res = append(res, s)
+		}
+	}
+	return res, nil
+}
+
+// Series converts the time series of the document, one Series per period.
+// The value is the quantity, or the imbalance or procurement price for price
+// documents. InDomain is the area (or control area) of the document.
+func (d *BalancingMarketDocument) Series() ([]Series, error) {
+	domain := d.AreaDomainMRID.Text
+	if domain == "" {
+		domain = d.ControlAreaDomainMRID.Text
+	}
+
+	res := make([]Series, 0, len(d.TimeSeries))
+	for _, ts := range d.TimeSeries {
+		for _, period := range ts.Period {
+			isPrice := false
+			points := make([]rawPoint, len(period.Point))
+			for i, p := range period.Point {
+				value := p.Quantity
+				if value == "" {
+					value = p.ImbalancePriceAmount
+				}
+				if value == "" {
+					value = p.ProcurementPriceAmount
+				}
+				if value != p.Quantity {
+					isPrice = true
+				}
+				points[i] = rawPoint{position: p.Position, value: value}
+			}
+
+			resolution := ResolutionType(period.Resolution)
+			start, end, parsed, err := parsePeriod(period.TimeInterval.Start, period.TimeInterval.End, resolution, CurveType(ts.CurveType), points, domainLocation(DomainType(domain)), true)
+			if err != nil {
+				return nil, err
+			}
+
+			s := Series{
+				MRID:         ts.MRID,
+				BusinessType: BusinessType(ts.BusinessType),
+				PsrType:      PsrType(ts.MktPSRTypePsrType),
+				InDomain:     DomainType(domain),
+				Unit:         ts.QuantityMeasureUnitName,
+				CurveType:    CurveType(ts.CurveType),
+				Resolution:   resolution,
+				Start:        start,
+				End:          end,
+				Points:       parsed,
+			}
+			if isPrice {
+				s.Unit = ts.PriceMeasureUnitName
+				s.Currency = ts.CurrencyUnitName
+			}
+			res = append(res, s)
+		}
+	}
+	return res, nil
+}
+
+// Series converts the available capacity of the document, one Series per
+// Available_Period. InDomain is the bidding zone, or the in domain of a
+// transmission asset. Outages are published as A03 blocks at a resolution of
+// a minute for months, so the points are not expanded: each one holds until
+// the next point, the last one until End.
+func (d *UnavailabilityMarketDocument) Series() ([]Series, error) {
+	ts := d.TimeSeries
+
+	domain := ts.BiddingZoneDomainMRID.Text
+	if domain == "" {
+		domain = ts.InDomainMRID.Text
+	}
+	psrType := ts.ProductionRegisteredResourcePSRTypePsrType
+	if psrType == "" {
+		psrType = ts.AssetRegisteredResource.AssetPSRTypePsrType
+	}
+
+	res := make([]Series, 0, len(ts.AvailablePeriod))
+	for _, period := range ts.AvailablePeriod {
+		points := make([]rawPoint, len(period.Point))
+		for i, p := range period.Point {
+			points[i] = rawPoint{position: p.Position, value: p.Quantity}
+		}
+
+		resolution := ResolutionType(period.Resolution)
+		start, end, parsed, err := parsePeriod(period.TimeInterval.Start, period.TimeInterval.End, resolution, CurveType(ts.CurveType), points, domainLocation(DomainType(domain)), false)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, Series{
+			MRID:         ts.MRID,
+			BusinessType: BusinessType(ts.BusinessType),
+			PsrType:      PsrType(psrType),
+			InDomain:     DomainType(domain),
+			OutDomain:    DomainType(ts.OutDomainMRID.Text),
+			Unit:         ts.QuantityMeasureUnitName,
+			CurveType:    CurveType(ts.CurveType),
+			Resolution:   resolution,
+			Start:        start,
+			End:          end,
+			Points:       parsed,
+		})
+	}
+	return res, nil
+}
+
+// slotValues spreads the points of s over slots of the given length, by slot start in Unix seconds.
+// A point of a coarser resolution fills every slot it covers, which suits average powers and prices.
+// Daily and longer points cover their local day, month or year in the market time zone of s,
+// 23 or 25 hours for a day on DST changes.
+// ok is false if the resolution is finer than the slot or invalid.
+func (s Series) slotValues(slot time.Duration) (values map[int64]float64, ok bool) {
+	res, err := s.Resolution.parse()
+	if err != nil || (res.years == 0 && res.months == 0 && res.days == 0 && res.clock < slot) {
+		return nil, false
+	}
+
+	loc := domainLocation(s.InDomain, s.OutDomain)
+	values = make(map[int64]float64, len(s.Points))
+	for _, p := range s.Points {
+		end, err := s.Resolution.Step(p.Time.In(loc), 1)
+		if err != nil {
+			return nil, false
+		}
+		for t := p.Time; t.Before(end); t = t.Add(slot) {
+			values[t.Unix()] = p.Value
+		}
+	}
+	return values, true
+}
@@ -0,0 +1,414 @@
+package entsoe
+
+import (
+	"encoding/xml"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testGLMarketDocument = `<?xml version="1.0" encoding="UTF-8"?>
+<GL_MarketDocument xmlns="urn:iec62325.351:tc57wg16:451-6:generationloaddocument:3:0">
+	<mRID>1</mRID>
+	<type>A75</type>
+	<process.processType>A16</process.processType>
+	<time_Period.timeInterval>
+		<start>2026-03-01T23:00Z</start>
+		<end>2026-03-02T00:00Z</end>
+	</time_Period.timeInterval>
+	<TimeSeries>
+		<mRID>1</mRID>
+		<businessType>A01</businessType>
+		<inBiddingZone_Domain.mRID codingScheme="A01">10YFR-RTE------C</inBiddingZone_Domain.mRID>
+		<quantity_Measure_Unit.name>MAW</quantity_Measure_Unit.name>
+		<curveType>A01</curveType>
+		<MktPSRType>
+			<psrType>B16</psrType>
+		</MktPSRType>
+		<Period>
+			<timeInterval>
+				<start>2026-03-01T23:00Z</start>
+				<end>2026-03-02T00:00Z</end>
+			</timeInterval>
+			<resolution>PT15M</resolution>
+			<Point>
+				<position>2</position>
+				<quantity>12</quantity>
+			</Point>
+			<Point>
+				<position>1</position>
+				<quantity>10</quantity>
+			</Point>
+			<Point>
+				<position>4</position>
+				<quantity>16.5</quantity>
+			</Point>
+		</Period>
+	</TimeSeries>
+</GL_MarketDocument>`
+
+func TestGLMarketDocumentSeries(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(testGLMarketDocument))
+	})
+
+	doc, err := c.GetAggregatedGenerationPerType(ProcessTypeRealised, PsrTypeSolar, DomainFR, genTime("202603012300"), genTime("202603020000"))
+	assert.Nil(t, err)
+
+	series, err := doc.Series()
+	assert.Nil(t, err)
+	assert.Len(t, series, 1)
+
+	s := series[0]
+	assert.Equal(t, PsrTypeSolar, s.PsrType)
+	assert.Equal(t, DomainType("10YFR-RTE------C"), s.InDomain)
+	assert.Equal(t, "MAW", s.Unit)
+	assert.Equal(t, "", s.Currency)
+	assert.Equal(t, ResolutionQuarter, s.Resolution)
+	assert.Equal(t, genTime("202603012300"), s.Start)
+	assert.Equal(t, genTime("202603020000"), s.End)
+	assert.Equal(t, []SeriesPoint{
+		{Time: genTime("202603012300"), Value: 10},
+		{Time: genTime("202603012315"), Value: 12},
+		{Time: genTime("202603012345"), Value: 16.5},
+	}, s.Points)
+}
+
+func TestPublicationMarketDocumentSeries(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(testPublicationMarketDocument))
+	})
+
+	doc, err := c.GetDayAheadPrices(DomainFR, genTime("202603012300"), genTime("202603020100"))
+	assert.Nil(t, err)
+
+	series, err := doc.Series()
+	assert.Nil(t, err)
+	assert.Len(t, series, 1)
+
+	s := series[0]
+	assert.Equal(t, DomainType("10YFR-RTE------C"), s.InDomain)
+	assert.Equal(t, ResolutionHour, s.Resolution)
+	assert.Equal(t, []SeriesPoint{
+		{Time: genTime("202603012300"), Value: 42.5},
+		{Time: genTime("202603020000"), Value: 40},
+	}, s.Points)
+}
+
+func TestParsePeriodUnsupportedResolution(t *testing.T) {
+	_, _, _, err := parsePeriod("2026-03-01T23:00Z", "2026-03-02T00:00Z", ResolutionType("15M"), CurveTypeSequentialFixedSizeBlock, []rawPoint{{position: "1", value: "1"}}, time.UTC, true)
+	assert.NotNil(t, err)
+
+	start, _, points, err := parsePeriod("2026-03-01T23:00Z", "2026-03-02T00:00Z", ResolutionHour, CurveTypeSequentialFixedSizeBlock, nil, time.UTC, true)
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2026, 3, 1, 23, 0, 0, 0, time.UTC), start)
+	assert.Empty(t, points)
+}
+
+// testMultiPeriodPublicationMarketDocument has a gap between its two periods,
+// and the second one changes resolution.
+const testMultiPeriodPublicationMarketDocument = `<?xml version="1.0" encoding="UTF-8"?>
+<Publication_MarketDocument xmlns="urn:iec62325.351:tc57wg16:451-3:publicationdocument:7:3">
+	<mRID>1</mRID>
+	<type>A44</type>
+	<period.timeInterval>
+		<start>2026-03-01T23:00Z</start>
+		<end>2026-03-02T03:00Z</end>
+	</period.timeInterval>
+	<TimeSeries>
+		<mRID>1</mRID>
+		<in_Domain.mRID codingScheme="A01">10YFR-RTE------C</in_Domain.mRID>
+		<currency_Unit.name>EUR</currency_Unit.name>
+		<price_Measure_Unit.name>MWH</price_Measure_Unit.name>
+		<curveType>A01</curveType>
+		<Period>
+			<timeInterval>
+				<start>2026-03-01T23:00Z</start>
+				<end>2026-03-02T01:00Z</end>
+			</timeInterval>
+			<resolution>PT60M</resolution>
+			<Point>
+				<position>1</position>
+				<price.amount>42.5</price.amount>
+			</Point>
+			<Point>
+				<position>2</position>
+				<price.amount>40</price.amount>
+			</Point>
+		</Period>
+		<Period>
+			<timeInterval>
+				<start>2026-03-02T02:00Z</start>
+				<end>2026-03-02T02:30Z</end>
+			</timeInterval>
+			<resolution>PT15M</resolution>
+			<Point>
+				<position>1</position>
+				<price.amount>30</price.amount>
+			</Point>
+			<Point>
+				<position>2</position>
+				<price.amount>31</price.amount>
+			</Point>
+		</Period>
+	</TimeSeries>
+</Publication_MarketDocument>`
+
+const testMultiPeriodGLMarketDocument = `<?xml version="1.0" encoding="UTF-8"?>
+<GL_MarketDocument xmlns="urn:iec62325.351:tc57wg16:451-6:generationloaddocument:3:0">
+	<mRID>1</mRID>
+	<type>A65</type>
+	<process.processType>A16</process.processType>
+	<TimeSeries>
+		<mRID>1</mRID>
+		<businessType>A04</businessType>
+		<outBiddingZone_Domain.mRID codingScheme="A01">10YFR-RTE------C</outBiddingZone_Domain.mRID>
+		<quantity_Measure_Unit.name>MAW</quantity_Measure_Unit.name>
+		<curveType>A01</curveType>
+		<Period>
+			<timeInterval>
+				<start>2026-03-01T23:00Z</start>
+				<end>2026-03-02T00:00Z</end>
+			</timeInterval>
+			<resolution>PT60M</resolution>
+			<Point>
+				<position>1</position>
+				<quantity>60000</quantity>
+			</Point>
+		</Period>
+		<Period>
+			<timeInterval>
+				<start>2026-03-02T00:00Z</start>
+				<end>2026-03-02T00:30Z</end>
+			</timeInterval>
+			<resolution>PT15M</resolution>
+			<Point>
+				<position>1</position>
+				<quantity>59000</quantity>
+			</Point>
+			<Point>
+				<position>2</position>
+				<quantity>58000</quantity>
+			</Point>
+		</Period>
+	</TimeSeries>
+</GL_MarketDocument>`
+
+func TestMultiPeriodPublicationMarketDocument(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(testMultiPeriodPublicationMarketDocument))
+	})
+
+	doc, err := c.GetDayAheadPrices(DomainFR, genTime("202603012300"), genTime("202603020300"))
+	assert.Nil(t, err)
+	assert.Len(t, doc.TimeSeries, 1)
+	assert.Len(t, doc.TimeSeries[0].Period, 2)
+
+	series, err := doc.Series()
+	assert.Nil(t, err)
+	assert.Len(t, series, 2)
+	assert.Equal(t, ResolutionHour, series[0].Resolution)
+	assert.Equal(t, "EUR", series[0].Currency)
+	assert.Equal(t, "MWH", series[0].Unit)
+	assert.Equal(t, ResolutionQuarter, series[1].Resolution)
+	assert.Equal(t, []SeriesPoint{
+		{Time: genTime("202603020200"), Value: 30},
+		{Time: genTime("202603020215"), Value: 31},
+	}, series[1].Points)
+}
+
+func TestMultiPeriodGLMarketDocument(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(testMultiPeriodGLMarketDocument))
+	})
+
+	doc, err := c.GetActualTotalLoad(DomainFR, genTime("202603012300"), genTime("202603020030"))
+	assert.Nil(t, err)
+
+	series, err := doc.Series()
+	assert.Nil(t, err)
+	assert.Len(t, series, 2)
+	assert.Equal(t, DomainType("10YFR-RTE------C"), series[0].OutDomain)
+	assert.Equal(t, []SeriesPoint{{Time: genTime("202603012300"), Value: 60000}}, series[0].Points)
+	assert.Equal(t, []SeriesPoint{
+		{Time: genTime("202603020000"), Value: 59000},
+		{Time: genTime("202603020015"), Value: 58000},
+	}, series[1].Points)
+}
+
+func TestDayAheadMultiPeriod(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(testMultiPeriodPublicationMarketDocument))
+	})
+
+	dayAhead, err := NewDayAhead(France, c)
+	assert.Nil(t, err)
+
+	prices, err := dayAhead.Fetch(genTime("202603012300"), genTime("202603020300"))
+	assert.Nil(t, err)
+
+	byTime := make(map[time.Time]float64, len(prices))
+	for _, p := range prices {
+		byTime[p.Time.UTC()] = p.Price_eur_per_MWh
+	}
+	assert.Equal(t, 42.5, byTime[genTime("202603012345")])
+	assert.Equal(t, 40.0, byTime[genTime("202603020045")])
+	assert.Equal(t, 30.0, byTime[genTime("202603020200")])
+	assert.Equal(t, 31.0, byTime[genTime("202603020215")])
+}
+
+func TestParsePeriodCurveTypes(t *testing.T) {
+	points := []rawPoint{
+		{position: "1", value: "10"},
+		{position: "3", value: "30"},
+	}
+
+	tests := []struct {
+		curveType CurveType
+		want      []SeriesPoint
+	}{
+		{CurveTypeSequentialFixedSizeBlock, []SeriesPoint{
+			{Time: genTime("202603012300"), Value: 10},
+			{Time: genTime("202603012330"), Value: 30},
+		}},
+		{CurveTypePoint, []SeriesPoint{
+			{Time: genTime("202603012300"), Value: 10},
+			{Time: genTime("202603012330"), Value: 30},
+		}},
+		{CurveTypeVariableSizedBlock, []SeriesPoint{
+			{Time: genTime("202603012300"), Value: 10},
+			{Time: genTime("202603012315"), Value: 10},
+			{Time: genTime("202603012330"), Value: 30},
+			{Time: genTime("202603012345"), Value: 30},
+		}},
+	}
+
+	for _, tt := range tests {
+		_, _, got, err := parsePeriod("2026-03-01T23:00Z", "2026-03-02T00:00Z", ResolutionQuarter, tt.curveType, points, time.UTC, true)
+		assert.Nil(t, err, tt.curveType)
+		assert.Equal(t, tt.want, got, tt.curveType)
+	}
+
+	// unexpanded blocks are taken as they are
+	_, _, got, err := parsePeriod("2026-03-01T23:00Z", "2026-03-02T00:00Z", ResolutionQuarter, CurveTypeVariableSizedBlock, points, time.UTC, false)
+	assert.Nil(t, err)
+	assert.Equal(t, []SeriesPoint{
+		{Time: genTime("202603012300"), Value: 10},
+		{Time: genTime("202603012330"), Value: 30},
+	}, got)
+}
+
+const testVariableSizedBlockPublicationMarketDocument = `<?xml version="1.0" encoding="UTF-8"?>
+<Publication_MarketDocument xmlns="urn:iec62325.351:tc57wg16:451-3:publicationdocument:7:3">
+	<mRID>1</mRID>
+	<type>A44</type>
+	<period.timeInterval>
+		<start>2026-03-01T23:00Z</start>
+		<end>2026-03-02T00:00Z</end>
+	</period.timeInterval>
+	<TimeSeries>
+		<mRID>1</mRID>
+		<in_Domain.mRID codingScheme="A01">10YFR-RTE------C</in_Domain.mRID>
+		<curveType>A03</curveType>
+		<Period>
+			<timeInterval>
+				<start>2026-03-01T23:00Z</start>
+				<end>2026-03-02T00:00Z</end>
+			</timeInterval>
+			<resolution>PT15M</resolution>
+			<Point>
+				<position>1</position>
+				<price.amount>50</price.amount>
+			</Point>
+			<Point>
+				<position>4</position>
+				<price.amount>55</price.amount>
+			</Point>
+		</Period>
+	</TimeSeries>
+</Publication_MarketDocument>`
+
+func TestDayAheadVariableSizedBlock(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(testVariableSizedBlockPublicationMarketDocument))
+	})
+
+	dayAhead, err := NewDayAhead(France, c)
+	assert.Nil(t, err)
+
+	prices, err := dayAhead.Fetch(genTime("202603012300"), genTime("202603020000"))
+	assert.Nil(t, err)
+
+	byTime := make(map[time.Time]float64, len(prices))
+	for _, p := range prices {
+		byTime[p.Time.UTC()] = p.Price_eur_per_MWh
+	}
+	assert.Equal(t, map[time.Time]float64{
+		genTime("202603012300"): 50,
+		genTime("202603012315"): 50,
+		genTime("202603012330"): 50,
+		genTime("202603012345"): 55,
+	}, byTime)
+}
+
+func TestUnavailabilityMarketDocumentSeries(t *testing.T) {
+	// the capacity is back to 350 MW after 48 hours
+	data := strings.Replace(testUnavailabilityMarketDocument,
+		"</Point>", "</Point>\n\t\t\t<Point>\n\t\t\t\t<position>2881</position>\n\t\t\t\t<quantity>350</quantity>\n\t\t\t</Point>", 1)
+
+	var doc UnavailabilityMarketDocument
+	assert.Nil(t, xml.Unmarshal([]byte(data), &doc))
+
+	series, err := doc.Series()
+	assert.Nil(t, err)
+	assert.Len(t, series, 1)
+
+	s := series[0]
+	assert.Equal(t, DomainCZ, s.InDomain)
+	assert.Equal(t, PsrType("B02"), s.PsrType)
+	assert.Equal(t, CurveTypeVariableSizedBlock, s.CurveType)
+	assert.Equal(t, ResolutionMinute, s.Resolution)
+	// the minute blocks of the four days are not expanded
+	assert.Equal(t, []SeriesPoint{
+		{Time: genTime("201601010000"), Value: 0},
+		{Time: genTime("201601030000"), Value: 350},
+	}, s.Points)
+	assert.Equal(t, genTime("201601050000"), s.End)
+}
+
+func TestDailySeriesDST(t *testing.T) {
+	if _, err := time.LoadLocation("Europe/Paris"); err != nil {
+		t.Skip(err)
+	}
+
+	// daily week-ahead loads of France from 2026-03-28, 2026-03-29 is 23 hours long
+	data := strings.Replace(testGLDocument(DocumentTypeSystemTotalLoad, "",
+		testSeries("A60", "", "2026-03-30T22:00Z", ResolutionDay, 900, 1000, 1100),
+	), testStart, "2026-03-27T23:00Z", 1)
+
+	var doc GLMarketDocument
+	assert.Nil(t, xml.Unmarshal([]byte(data), &doc))
+	series, err := doc.Series()
+	assert.Nil(t, err)
+	if !assert.Len(t, series, 1) {
+		return
+	}
+
+	s := series[0]
+	assert.Equal(t, []SeriesPoint{
+		{Time: genTime("202603272300"), Value: 900},
+		{Time: genTime("202603282300"), Value: 1000},
+		{Time: genTime("202603292200"), Value: 1100},
+	}, s.Points)
+
+	values, ok := s.slotValues(resolution15m)
+	assert.True(t, ok)
+	slots := map[float64]int{}
+	for _, v := range values {
+		slots[v]++
+	}
+	assert.Equal(t, map[float64]int{900: 96, 1000: 92, 1100: 96}, slots)
+}
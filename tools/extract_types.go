@@ -4,6 +4,7 @@ import (
 	"archive/zip"
 	"bytes"
 	"fmt"
+	"go/format"
 	"io"
 	"log"
 	"net/http"
@@ -203,6 +204,15 @@ var sampleRequests = map[string]string{
 	"4.7.5.": "documentType=A77&businessType=A53&biddingZone_Domain=10YCZ-CEPS-----N&periodStart=201512312300&periodEnd=201612312300",
 }
 
+// handWritten are the documents maintained by hand outside GEN_GO_TYPES_FILE,
+// zek output for them is dropped.
+var handWritten = map[string]bool{
+	// masterdata.go
+	"Configuration_MarketDocument": true,
+	// unavailability.go
+	"Unavailability_MarketDocument": true,
+}
+
 // regex to extract response document
 var re = regexp.MustCompile(`<(.+?)\s+xmlns=.+?>`)
 
@@ -270,10 +280,16 @@ func main() {
 		log.Fatalln(err)
 	}
 	defer f.Close()
-	f.Write([]byte("package entsoe\n\n"))
-	f.Write([]byte("import \"encoding/xml\"\n\n"))
+
+	gen := &bytes.Buffer{}
+	gen.Write([]byte("package entsoe\n\n"))
+	gen.Write([]byte("import \"encoding/xml\"\n\n"))
 
 	for dir := range createdDirs {
+		if handWritten[dir] {
+			log.Printf("skipping hand written %s\n", dir)
+			continue
+		}
 		shell := "bash"
 
 		cmdOutput := &bytes.Buffer{}
@@ -295,11 +311,28 @@ func main() {
 		if err != nil {
 			log.Fatalln(err)
 		}
-		f.Write(cmdOutput.Bytes())
+		gen.Write(repeatElements(cmdOutput.Bytes()))
 	}
+
+	src, err := format.Source(gen.Bytes())
+	if err != nil {
+		log.Fatalln(err)
+	}
+	f.Write(src)
 	log.Println("FINISHED")
 }
 
+// repeatedElement matches the struct fields of the elements which may repeat although
+// the samples hold only one: every Period of a TimeSeries, every Point of a Period and
+// every Reason, such as a code followed by a free text.
+var repeatedElement = regexp.MustCompile(`(?m)^(\t+)(\w*Period|Point|Reason)(\s+)struct \{`)
+
+// repeatElements turns the fields of repeated elements generated by zek into slices,
+// otherwise decoding keeps only the last one.
+func repeatElements(src []byte) []byte {
+	return repeatedElement.ReplaceAll(src, []byte("${1}${2}${3}[]struct {"))
+}
+
 func readZipFile(zf *zip.File) ([]byte, error) {
 	f, err := zf.Open()
 	if err != nil {
@@ -16,6 +16,7 @@ const (
 	ParameterOutBiddingZoneDomain                                     = "outBiddingZone_Domain"
 	ParameterBiddingZoneDomain                                        = "biddingZone_Domain"
 	ParameterControlAreaDomain                                        = "controlArea_Domain"
+	ParameterAreaDomain                                               = "area_Domain"
 	ParameterInDomain                                                 = "in_Domain"
 	ParameterOutDomain                                                = "out_Domain"
 	ParameterAcquiringDomain                                          = "acquiring_Domain"
@@ -28,6 +29,9 @@ const (
 	ParameterPeriodStartUpdate                                        = "PeriodStartUpdate"
 	ParameterPeriodEndUpdate                                          = "PeriodEndUpdate"
 	ParameterSecurityToken                                            = "securityToken"
+	ParameterMRID                                                     = "mRID"
+	ParameterOffset                                                   = "offset"
+	ParameterImplementationDateAndOrTime                              = "implementation_DateAndOrTime"
 )
 
 type ContractMarketAgreementType string
@@ -179,6 +183,7 @@ const (
 	DocStatusFinal        DocStatus = "A02"
 	DocStatusActive       DocStatus = "A05"
 	DocStatusCancelled    DocStatus = "A09"
+	DocStatusWithdrawn    DocStatus = "A13"
 	DocStatusEstimated    DocStatus = "X01"
 )
 
@@ -242,6 +247,7 @@ const (
 	REG AreaType = "Region"
 	SCA AreaType = "Scheduling Area"
 	SNA AreaType = "Synchronous Area"
+	CTY AreaType = "Country"
 )
 
 type DomainType = string
@@ -370,10 +376,21 @@ const (
 type ResolutionType string
 
 const (
-	ResolutionQuarter  ResolutionType = "PT15M"
-	ResolutionHalfHour ResolutionType = "PT30M"
-	ResolutionHour     ResolutionType = "PT60M"
-	ResolutionDay      ResolutionType = "P1D"
-	ResolutionWeek     ResolutionType = "P7D"
-	ResolutionYear     ResolutionType = "P1Y"
+	ResolutionMinute      ResolutionType = "PT1M"
+	ResolutionFiveMinutes ResolutionType = "PT5M"
+	ResolutionQuarter     ResolutionType = "PT15M"
+	ResolutionHalfHour    ResolutionType = "PT30M"
+	ResolutionHour        ResolutionType = "PT60M"
+	ResolutionDay         ResolutionType = "P1D"
+	ResolutionWeek        ResolutionType = "P7D"
+	ResolutionMonth       ResolutionType = "P1M"
+	ResolutionYear        ResolutionType = "P1Y"
+)
+
+type CurveType string
+
+const (
+	CurveTypeSequentialFixedSizeBlock CurveType = "A01"
+	CurveTypePoint                    CurveType = "A02"
+	CurveTypeVariableSizedBlock       CurveType = "A03"
 )
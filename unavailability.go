@@ -0,0 +1,115 @@
+package entsoe
+
+import "encoding/xml"
+
+// UnavailabilityMarketDocument was generated 2024-02-29 09:51:34 and has been
+// extended by hand since, with the fields of the transmission and production
+// outages missing from the sample, so it is maintained here and not in documents.go.
+type UnavailabilityMarketDocument struct {
+	XMLName                     xml.Name `xml:"Unavailability_MarketDocument"`
+	Text                        string   `xml:",chardata"`
+	Xmlns                       string   `xml:"xmlns,attr"`
+	MRID                        string   `xml:"mRID"`                // kjfQMlmtlZVC32VliNsNQg, -...
+	RevisionNumber              string   `xml:"revisionNumber"`      // 2, 1, 3, 3, 3, 3, 3, 2, 2...
+	Type                        string   `xml:"type"`                // A79, A79, A79, A79, A79, ...
+	ProcessProcessType          string   `xml:"process.processType"` // A26, A26, A26, A26, A26, ...
+	CreatedDateTime             string   `xml:"createdDateTime"`     // 2016-05-13T06:19:51Z, 201...
+	SenderMarketParticipantMRID struct {
+		Text         string `xml:",chardata"` // 10X1001A1001A450, 10X1001...
+		CodingScheme string `xml:"codingScheme,attr"`
+	} `xml:"sender_MarketParticipant.mRID"`
+	SenderMarketParticipantMarketRoleType string `xml:"sender_MarketParticipant.marketRole.type"` // A32, A32, A32, A32, A32, ...
+	ReceiverMarketParticipantMRID         struct {
+		Text         string `xml:",chardata"` // 10X1001A1001A450, 10X1001...
+		CodingScheme string `xml:"codingScheme,attr"`
+	} `xml:"receiver_MarketParticipant.mRID"`
+	ReceiverMarketParticipantMarketRoleType string `xml:"receiver_MarketParticipant.marketRole.type"` // A33, A33, A33, A33, A33, ...
+	UnavailabilityTimePeriodTimeInterval    struct {
+		Text  string `xml:",chardata"`
+		Start string `xml:"start"` // 2015-11-23T17:50Z, 2015-1...
+		End   string `xml:"end"`   // 2016-05-12T19:51Z, 2016-0...
+	} `xml:"unavailability_Time_Period.timeInterval"`
+	TimeSeries struct {
+		Text                  string `xml:",chardata"`
+		MRID                  string `xml:"mRID"`         // 1, 1, 1, 1, 1, 1, 1, 1, 1...
+		BusinessType          string `xml:"businessType"` // A54, A54, A54, A54, A54, ...
+		BiddingZoneDomainMRID struct {
+			Text         string `xml:",chardata"` // 10YDE-EON------1, 10YDE-E...
+			CodingScheme string `xml:"codingScheme,attr"`
+		} `xml:"biddingZone_Domain.mRID"`
+		InDomainMRID struct {
+			Text         string `xml:",chardata"` // 10YCZ-CEPS-----N
+			CodingScheme string `xml:"codingScheme,attr"`
+		} `xml:"in_Domain.mRID"`
+		OutDomainMRID struct {
+			Text         string `xml:",chardata"` // 10YSK-SEPS-----K
+			CodingScheme string `xml:"codingScheme,attr"`
+		} `xml:"out_Domain.mRID"`
+		StartDateAndOrTimeDate           string `xml:"start_DateAndOrTime.date"`   // 2015-11-23, 2015-12-29, 2...
+		StartDateAndOrTimeTime           string `xml:"start_DateAndOrTime.time"`   // 17:50:00Z, 19:43:00Z, 07:...
+		EndDateAndOrTimeDate             string `xml:"end_DateAndOrTime.date"`     // 2016-05-12, 2016-01-05, 2...
+		EndDateAndOrTimeTime             string `xml:"end_DateAndOrTime.time"`     // 19:51:00Z, 19:43:00Z, 16:...
+		QuantityMeasureUnitName          string `xml:"quantity_Measure_Unit.name"` // MAW, MAW, MAW, MAW, MAW, ...
+		CurveType                        string `xml:"curveType"`                  // A03, A03, A03, A03, A03, ...
+		ProductionRegisteredResourceMRID struct {
+			Text         string `xml:",chardata"` // 27W-PU-EPC1----Y
+			CodingScheme string `xml:"codingScheme,attr"`
+		} `xml:"production_RegisteredResource.mRID"`
+		ProductionRegisteredResourceName                                string `xml:"production_RegisteredResource.name"`                              // EPC1
+		ProductionRegisteredResourceLocationName                        string `xml:"production_RegisteredResource.location.name"`                     // Pocerady
+		ProductionRegisteredResourcePSRTypePsrType                      string `xml:"production_RegisteredResource.pSRType.psrType"`                   // B02
+		ProductionRegisteredResourcePSRTypePowerSystemResourcesName     string `xml:"production_RegisteredResource.pSRType.powerSystemResources.name"` // EPC1_G1
+		ProductionRegisteredResourcePSRTypePowerSystemResourcesMRID     string `xml:"production_RegisteredResource.pSRType.powerSystemResources.mRID"` // 27W-GU-EPC1G1-X
+		ProductionRegisteredResourcePSRTypePowerSystemResourcesNominalP struct {
+			Text string `xml:",chardata"` // 113, 156, 864, 144, 144, ...
+			Unit string `xml:"unit,attr"`
+		} `xml:"production_RegisteredResource.pSRType.powerSystemResources.nominalP"`
+		AssetRegisteredResource struct {
+			Text string `xml:",chardata"`
+			MRID struct {
+				Text         string `xml:",chardata"` // 11TD2L000000267O, 11TD2L0...
+				CodingScheme string `xml:"codingScheme,attr"`
+			} `xml:"mRID"`
+			Name                string `xml:"name"`                  // L-155-RIFF-EMDB-AC114, L-...
+			AssetPSRTypePsrType string `xml:"asset_PSRType.psrType"` // B21, B21, B23, B21, B21, ...
+			LocationName        string `xml:"location.name"`         // Riffgat-Emden/Borssum, Bo...
+		} `xml:"Asset_RegisteredResource"`
+		WindPowerFeedinPeriod []struct {
+			Text         string `xml:",chardata"`
+			TimeInterval struct {
+				Text  string `xml:",chardata"`
+				Start string `xml:"start"` // 2015-11-23T17:50Z, 2015-1...
+				End   string `xml:"end"`   // 2016-05-12T19:51Z, 2016-0...
+			} `xml:"timeInterval"`
+			Resolution string `xml:"resolution"` // PT1M, PT1M, PT1M, PT1M, P...
+			Point      []struct {
+				Text     string `xml:",chardata"`
+				Position string `xml:"position"` // 1, 1, 1, 1, 1, 1, 1, 1, 1...
+				Quantity string `xml:"quantity"` // 0, 80, 545, 142, 141, 141...
+			} `xml:"Point"`
+		} `xml:"WindPowerFeedin_Period"`
+		AvailablePeriod []struct {
+			Text         string `xml:",chardata"`
+			TimeInterval struct {
+				Text  string `xml:",chardata"`
+				Start string `xml:"start"` // 2015-11-23T17:50Z
+				End   string `xml:"end"`   // 2016-05-12T19:51Z
+			} `xml:"timeInterval"`
+			Resolution string `xml:"resolution"` // PT1M
+			Point      []struct {
+				Text     string `xml:",chardata"`
+				Position string `xml:"position"` // 1
+				Quantity string `xml:"quantity"` // 0, 350
+			} `xml:"Point"`
+		} `xml:"Available_Period"`
+	} `xml:"TimeSeries"`
+	DocStatus struct {
+		Text  string `xml:",chardata"`
+		Value string `xml:"value"` // A05, A09
+	} `xml:"docStatus"`
+	Reason []struct {
+		Text       string `xml:",chardata"`
+		Code       string `xml:"code"` // B18, B18, B18, B18, B18, ...
+		ReasonText string `xml:"text"`
+	} `xml:"Reason"`
+}
@@ -0,0 +1,83 @@
+package entsoe
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// DefaultMaxDecompressedSize caps the total size of the documents unpacked
+// from a single zip response.
+const DefaultMaxDecompressedSize = 256 << 20
+
+var zipMagic = []byte("PK\x03\x04")
+
+// WithMaxDecompressedSize sets the maximum total size, in bytes, of the documents
+// unpacked from a zip response. Larger archives fail with an error.
+// A size of 0 or less keeps DefaultMaxDecompressedSize.
+func WithMaxDecompressedSize(size int64) ClientOption {
+	return func(c *EntsoeClient) {
+		if size <= 0 {
+			size = DefaultMaxDecompressedSize
+		}
+		c.maxDecompressedSize = size
+	}
+}
+
+func isZip(contentType string, body []byte) bool {
+	return strings.Contains(strings.ToLower(contentType), "zip") || bytes.HasPrefix(body, zipMagic)
+}
+
+// unpackResponse splits a response into its XML documents. Outages and some
+// master data are returned as a zip archive holding one document per file,
+// everything else is a single document.
+func unpackResponse(contentType string, body []byte, maxSize int64) ([][]byte, error) {
+	if !isZip(contentType, body) {
+		return [][]byte{body}, nil
+	}
+
+	zipReader, err := zip.NewReader(bytes.NewReader(body), int64(len(body)))
+	if err != nil {
+		return nil, fmt.Errorf("Error reading zip response: %w", err)
+	}
+
+	remaining := maxSize
+	docs := make([][]byte, 0, len(zipReader.File))
+	for _, zipFile := range zipReader.File {
+		if zipFile.FileInfo().IsDir() {
+			continue
+		}
+		if zipFile.UncompressedSize64 > uint64(remaining) {
+			return nil, fmt.Errorf("zip response exceeds %d bytes once decompressed", maxSize)
+		}
+		data, err := readZipFile(zipFile, remaining)
+		if err != nil {
+			return nil, err
+		}
+		if int64(len(data)) > remaining {
+			return nil, fmt.Errorf("zip response exceeds %d bytes once decompressed", maxSize)
+		}
+		remaining -= int64(len(data))
+		logger.Debug().Str("file", zipFile.Name).Int("size", len(data)).Msg("unzipped document")
+		docs = append(docs, data)
+	}
+	return docs, nil
+}
+
+// readZipFile reads at most limit+1 bytes, so that a file lying about its size in the
+// zip header is detected without being fully decompressed.
+func readZipFile(zipFile *zip.File, limit int64) ([]byte, error) {
+	f, err := zipFile.Open()
+	if err != nil {
+		return nil, fmt.Errorf("Error opening %s from zip response: %w", zipFile.Name, err)
+	}
+	defer f.Close()
+
+	data, err := io.ReadAll(io.LimitReader(f, limit+1))
+	if err != nil {
+		return nil, fmt.Errorf("Error reading %s from zip response: %w", zipFile.Name, err)
+	}
+	return data, nil
+}
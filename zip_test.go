@@ -0,0 +1,144 @@
+package entsoe
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testZip(t *testing.T, files ...string) []byte {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for i, content := range files {
+		f, err := w.Create(string(rune('a'+i)) + ".xml")
+		if err != nil {
+			t.Fatal(err)
+		}
+		f.Write([]byte(content))
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestZipResponse(t *testing.T) {
+	body := testZip(t, testPublicationMarketDocument, testPublicationMarketDocument)
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/zip")
+		w.Write(body)
+	})
+
+	docs, err := c.requestPublicationMarketDocuments(context.Background(), testParams())
+	assert.Nil(t, err)
+	assert.Len(t, docs, 2)
+
+	doc, err := c.GetDayAheadPrices(DomainFR, genTime("202603012300"), genTime("202603020100"))
+	assert.Nil(t, err)
+	assert.Len(t, doc.TimeSeries, 2)
+}
+
+func TestZipResponseDetectedByMagicBytes(t *testing.T) {
+	body := testZip(t, testPublicationMarketDocument)
+	docs, err := unpackResponse("application/octet-stream", body, DefaultMaxDecompressedSize)
+	assert.Nil(t, err)
+	assert.Len(t, docs, 1)
+	assert.Equal(t, testPublicationMarketDocument, string(docs[0]))
+}
+
+func TestZipResponseTooLarge(t *testing.T) {
+	body := testZip(t, testPublicationMarketDocument, testPublicationMarketDocument)
+	_, err := unpackResponse("application/zip", body, int64(len(testPublicationMarketDocument)+10))
+	assert.NotNil(t, err)
+}
+
+func TestWithMaxDecompressedSize(t *testing.T) {
+	for size, want := range map[int64]int64{
+		1 << 10: 1 << 10,
+		0:       DefaultMaxDecompressedSize,
+		-1:      DefaultMaxDecompressedSize,
+	} {
+		c := NewEntsoeClient("token", WithMaxDecompressedSize(size))
+		assert.Equal(t, want, c.maxDecompressedSize, size)
+	}
+
+	// a non positive size does not reject the archives
+	body := testZip(t, testPublicationMarketDocument)
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/zip")
+		w.Write(body)
+	}, WithMaxDecompressedSize(-1))
+	_, err := c.GetDayAheadPrices(DomainFR, genTime("202603012300"), genTime("202603020000"))
+	assert.Nil(t, err)
+}
+
+const testUnavailabilityMarketDocument = `<?xml version="1.0" encoding="UTF-8"?>
+<Unavailability_MarketDocument xmlns="urn:iec62325.351:tc57wg16:451-6:outagedocument:3:0">
+	<mRID>kjfQMlmtlZVC32VliNsNQg</mRID>
+	<revisionNumber>2</revisionNumber>
+	<type>A80</type>
+	<TimeSeries>
+		<mRID>1</mRID>
+		<businessType>A53</businessType>
+		<biddingZone_Domain.mRID codingScheme="A01">10YCZ-CEPS-----N</biddingZone_Domain.mRID>
+		<curveType>A03</curveType>
+		<production_RegisteredResource.mRID codingScheme="A01">27W-PU-EPC1----Y</production_RegisteredResource.mRID>
+		<production_RegisteredResource.pSRType.psrType>B02</production_RegisteredResource.pSRType.psrType>
+		<Available_Period>
+			<timeInterval>
+				<start>2016-01-01T00:00Z</start>
+				<end>2016-01-05T00:00Z</end>
+			</timeInterval>
+			<resolution>PT1M</resolution>
+			<Point>
+				<position>1</position>
+				<quantity>0</quantity>
+			</Point>
+		</Available_Period>
+	</TimeSeries>
+	<docStatus>
+		<value>A05</value>
+	</docStatus>
+	<Reason>
+		<code>B18</code>
+	</Reason>
+	<Reason>
+		<code>B19</code>
+		<text>Planned maintenance</text>
+	</Reason>
+</Unavailability_MarketDocument>`
+
+func TestUnavailabilityZipResponse(t *testing.T) {
+	body := testZip(t, testUnavailabilityMarketDocument, testUnavailabilityMarketDocument)
+	var query url.Values
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Header().Set("Content-Type", "application/zip")
+		w.Write(body)
+	})
+
+	docStatus := DocStatusActive
+	offset := UnavailabilityPageSize
+	docs, err := c.GetUnavailabilityOfGenerationUnits(
+		DomainCZ,
+		genTime("201512312300"),
+		genTime("201612312300"),
+		&UnavailabilityFilter{DocStatus: &docStatus, Offset: &offset},
+	)
+	assert.Nil(t, err)
+	assert.Len(t, docs, 2)
+	assert.Equal(t, "A05", query.Get(ParameterDocStatus))
+	assert.Equal(t, "200", query.Get(ParameterOffset))
+	assert.Equal(t, DomainCZ, query.Get(ParameterBiddingZoneDomain))
+	assert.Equal(t, "27W-PU-EPC1----Y", docs[0].TimeSeries.ProductionRegisteredResourceMRID.Text)
+	assert.Len(t, docs[0].TimeSeries.AvailablePeriod, 1)
+	if assert.Len(t, docs[0].Reason, 2) {
+		assert.Equal(t, "B18", docs[0].Reason[0].Code)
+		assert.Equal(t, "Planned maintenance", docs[0].Reason[1].ReasonText)
+	}
+}